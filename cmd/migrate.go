@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os/signal"
+	"syscall"
+
+	"github.com/kubeflow/model-registry/internal/migrations"
+	"github.com/kubeflow/model-registry/internal/mlmdconn"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// migrateCmd represents the migrate command
+	migrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrates the Model Registry schema stored in MLMD",
+		Long: `This command applies, in order, the pending Model Registry schema migrations
+to the MLMD store, recording the resulting schema version in the store itself.
+
+Use --status to only print the current schema version and the pending migrations,
+and --dry-run to print the actions each pending migration would perform without
+altering the store.`,
+		RunE: runMigrate,
+	}
+)
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	mlmdAddr := fmt.Sprintf("%s:%d", proxyCfg.MLMDHostname, proxyCfg.MLMDPort)
+	slog.Info("connecting to MLMD server", "address", mlmdAddr)
+	conn, err := mlmdconn.Dial(ctx, mlmdAddr, proxyCfg.MLMD)
+	if err != nil {
+		return fmt.Errorf("error dialing connection to mlmd server %s: %v", mlmdAddr, err)
+	}
+	defer conn.Close()
+
+	migrator := migrations.NewMigrator(conn, proxyCfg.TypeNames)
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "current schema version: %d\nlatest schema version: %d\n", status.Current, status.Latest)
+	if len(status.Pending) == 0 {
+		fmt.Fprintln(out, "schema is up to date")
+		return nil
+	}
+	fmt.Fprintln(out, "pending migrations:")
+	for _, migration := range status.Pending {
+		fmt.Fprintf(out, "  %d: %s\n", migration.Version, migration.Description)
+	}
+	if migrateCfg.StatusOnly {
+		return nil
+	}
+
+	results, err := migrator.Migrate(ctx, migrateCfg.TargetVersion, migrateCfg.DryRun)
+	for _, result := range results {
+		verb := "applied"
+		if migrateCfg.DryRun {
+			verb = "would apply"
+		}
+		fmt.Fprintf(out, "%s migration %d: %s\n", verb, result.Migration.Version, result.Migration.Description)
+		for _, action := range result.Actions {
+			fmt.Fprintf(out, "  - %s\n", action)
+		}
+	}
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+
+	addMLMDFlags(migrateCmd.Flags())
+
+	migrateCmd.Flags().BoolVar(&migrateCfg.DryRun, "dry-run", migrateCfg.DryRun, "Print the actions of pending migrations without altering the store")
+	migrateCmd.Flags().BoolVar(&migrateCfg.StatusOnly, "status", migrateCfg.StatusOnly, "Only print the current schema version and the pending migrations")
+	migrateCmd.Flags().IntVar(&migrateCfg.TargetVersion, "to-version", migrateCfg.TargetVersion, "Schema version to migrate to, the latest one when 0")
+}
+
+type MigrateConfig struct {
+	DryRun        bool
+	StatusOnly    bool
+	TargetVersion int
+}
+
+var migrateCfg = MigrateConfig{
+	DryRun:        false,
+	StatusOnly:    false,
+	TargetVersion: 0,
+}
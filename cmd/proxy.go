@@ -2,17 +2,59 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/go-chi/chi/v5"
+	"github.com/kubeflow/model-registry/internal/certs"
+	"github.com/kubeflow/model-registry/internal/health"
+	"github.com/kubeflow/model-registry/internal/logging"
+	"github.com/kubeflow/model-registry/internal/migrations"
+	"github.com/kubeflow/model-registry/internal/mlmdconn"
 	"github.com/kubeflow/model-registry/internal/mlmdtypes"
+	"github.com/kubeflow/model-registry/internal/objectstore"
 	"github.com/kubeflow/model-registry/internal/server/openapi"
+	"github.com/kubeflow/model-registry/internal/tracing"
 	"github.com/kubeflow/model-registry/pkg/core"
+	"github.com/kubeflow/model-registry/pkg/metrics"
+	"github.com/kubeflow/model-registry/pkg/modelcard"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/validation"
+	"github.com/mitchellh/mapstructure"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// metricsPath is where Prometheus metrics are exposed
+	metricsPath = "/metrics"
+	// livenessPath reports whether the proxy process is alive
+	livenessPath = "/healthz"
+	// readinessPath reports whether the proxy can serve requests, checking MLMD connectivity
+	readinessPath = "/readyz"
+	// logLevelPath reports and changes the log level at runtime
+	logLevelPath = "/admin/log-level"
+
+	// validationConfigKey is the config file key holding the validation settings and rules
+	validationConfigKey = "validation"
+	// storageCredentialsConfigKey is the config file key listing the storages serving model artifact content, by
+	// the storageKey of the artifacts
+	storageCredentialsConfigKey = "storageCredentials"
 )
 
 var (
@@ -29,42 +71,199 @@ hostname and port where it listens.'`,
 )
 
 func runProxyServer(cmd *cobra.Command, args []string) error {
-	glog.Infof("proxy server started at %s:%v", cfg.Hostname, cfg.Port)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	shutdownTracing, err := tracing.Setup(ctx, proxyCfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("error setting up tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Warn("error shutting down tracing", "error", err)
+		}
+	}()
+
+	var serviceOpts []core.Option
+	if proxyCfg.PropertySchemasFile != "" {
+		schemas, err := readPropertySchemas(proxyCfg.PropertySchemasFile)
+		if err != nil {
+			return fmt.Errorf("error reading property schemas: %v", err)
+		}
+		serviceOpts = append(serviceOpts, core.WithPropertySchemas(schemas...))
+	}
+
+	var storeOpts []openapi.ServiceOption
+	var stores []objectstore.Store
+	objectStore, err := objectstore.New(proxyCfg.Storage)
+	if err != nil {
+		return fmt.Errorf("error setting up model artifact storage: %v", err)
+	}
+	if objectStore != nil {
+		slog.Info("model artifact uploads enabled", "storage", proxyCfg.Storage.Type)
+		storeOpts = append(storeOpts, openapi.WithObjectStore(objectStore, proxyCfg.Storage.StorageKey))
+		stores = append(stores, objectStore)
+	}
+	// storage credentials are only declared in the config file, as a list they can't be provided as flags
+	if err := viper.UnmarshalKey(storageCredentialsConfigKey, &proxyCfg.StorageCredentials); err != nil {
+		return fmt.Errorf("error reading %s config: %v", storageCredentialsConfigKey, err)
+	}
+	for i, credential := range proxyCfg.StorageCredentials {
+		if credential.StorageKey == "" || credential.Type == objectstore.TypeNone {
+			return fmt.Errorf("invalid %s[%d] config, storageKey and type are required", storageCredentialsConfigKey, i)
+		}
+		store, err := objectstore.New(credential)
+		if err != nil {
+			return fmt.Errorf("error setting up storage of storage key %s: %v", credential.StorageKey, err)
+		}
+		storeOpts = append(storeOpts, openapi.WithStorageCredential(credential.StorageKey, store, credential.AllowedPrefixes...))
+		stores = append(stores, store)
+	}
+
+	var validator *validation.Validator
+	if proxyCfg.ValidationEnabled {
+		// rules are only declared in the config file, as a list they can't be provided as flags.
+		// Lists in the config file replace the default ones, instead of being merged element by element.
+		if viper.IsSet(validationConfigKey) {
+			if err := viper.UnmarshalKey(validationConfigKey, &proxyCfg.Validation, func(c *mapstructure.DecoderConfig) { c.ZeroFields = true }); err != nil {
+				return fmt.Errorf("error reading %s config: %v", validationConfigKey, err)
+			}
+		}
+		proxyCfg.Validation.UriSchemes = allowStoreSchemes(proxyCfg.Validation.UriSchemes, stores)
+		if validator, err = validation.NewValidator(proxyCfg.Validation); err != nil {
+			return fmt.Errorf("error setting up validation: %v", err)
+		}
+	}
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*30)
-	defer cancel()
+	registryMetrics, err := metrics.NewMetrics(prometheus.DefaultRegisterer)
+	if err != nil {
+		return fmt.Errorf("error registering metrics: %v", err)
+	}
 
 	mlmdAddr := fmt.Sprintf("%s:%d", proxyCfg.MLMDHostname, proxyCfg.MLMDPort)
-	glog.Infof("connecting to MLMD server %s..", mlmdAddr)
-	conn, err := grpc.DialContext(
-		ctxTimeout,
+	slog.Info("connecting to MLMD server", "address", mlmdAddr)
+	conn, err := mlmdconn.Dial(
+		ctx,
 		mlmdAddr,
-		grpc.WithReturnConnectionError(),
-		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		proxyCfg.MLMD,
+		grpc.WithChainUnaryInterceptor(registryMetrics.UnaryClientInterceptor()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	)
 	if err != nil {
 		return fmt.Errorf("error dialing connection to mlmd server %s: %v", mlmdAddr, err)
 	}
 	defer conn.Close()
-	glog.Infof("connected to MLMD server")
+	slog.Info("connected to MLMD server")
 
-	mlmdTypeNamesConfig := mlmdtypes.NewMLMDTypeNamesConfigFromDefaults()
-	_, err = mlmdtypes.CreateMLMDTypes(conn, mlmdTypeNamesConfig)
+	mlmdTypeNamesConfig := proxyCfg.TypeNames
+	var typesMap map[string]int64
+	if proxyCfg.SkipTypeCreation {
+		slog.Info("validating existing MLMD types, skipping their creation")
+		typesMap, err = mlmdtypes.ValidateMLMDTypes(conn, mlmdTypeNamesConfig)
+		if err != nil {
+			return fmt.Errorf("error validating MLMD types: %v", err)
+		}
+	} else {
+		typesMap, err = mlmdtypes.CreateMLMDTypes(conn, mlmdTypeNamesConfig)
+		if err != nil {
+			return fmt.Errorf("error creating MLMD types: %v", err)
+		}
+	}
+	schemaStatus, err := migrations.NewMigrator(conn, mlmdTypeNamesConfig).Status(ctx)
 	if err != nil {
-		return fmt.Errorf("error creating MLMD types: %v", err)
+		return fmt.Errorf("error checking schema version: %v", err)
 	}
-	service, err := core.NewModelRegistryService(conn, mlmdTypeNamesConfig)
+	if len(schemaStatus.Pending) > 0 {
+		slog.Warn("MLMD store schema is outdated, run `model-registry migrate` to apply pending migrations", "version", schemaStatus.Current, "latest", schemaStatus.Latest)
+	}
+
+	service, err := core.NewModelRegistryService(conn, mlmdTypeNamesConfig, serviceOpts...)
 	if err != nil {
 		return fmt.Errorf("error creating core service: %v", err)
 	}
 
-	ModelRegistryServiceAPIService := openapi.NewModelRegistryServiceAPIService(service)
+	var apiServiceOpts []openapi.ServiceOption
+	if validator != nil {
+		service = validator.ValidatingApi(service)
+		apiServiceOpts = append(apiServiceOpts, openapi.WithValidator(validator))
+	}
+	if proxyCfg.ModelCardTemplatesDir != "" {
+		renderer, err := modelcard.NewRenderer(proxyCfg.ModelCardTemplatesDir)
+		if err != nil {
+			return fmt.Errorf("error loading model card templates: %v", err)
+		}
+		apiServiceOpts = append(apiServiceOpts, openapi.WithModelCardRenderer(renderer))
+	}
+	apiServiceOpts = append(apiServiceOpts, storeOpts...)
+	ModelRegistryServiceAPIService := openapi.NewModelRegistryServiceAPIService(registryMetrics.InstrumentApi(service), apiServiceOpts...)
 	ModelRegistryServiceAPIController := openapi.NewModelRegistryServiceAPIController(ModelRegistryServiceAPIService)
 
-	router := openapi.NewRouter(ModelRegistryServiceAPIController)
+	var apiHandler http.Handler = openapi.NewTenantRouter(proxyCfg.TenantHeader, ModelRegistryServiceAPIController)
+	if proxyCfg.MultiTenancy {
+		slog.Info("multi-tenancy enabled", "header", proxyCfg.TenantHeader)
+		apiHandler = openapi.TenantMiddleware(proxyCfg.TenantHeader)(apiHandler)
+	}
+
+	router := chi.NewRouter()
+	router.Use(logging.RequestIdMiddleware)
+	router.Use(tracing.Middleware)
+	router.Use(registryMetrics.Middleware)
+	router.Handle(metricsPath, promhttp.Handler())
+	router.Handle(livenessPath, health.NewChecker(map[string]health.Check{}))
+	router.Handle(readinessPath, health.NewChecker(map[string]health.Check{
+		"mlmd-connection": health.MLMDConnectionCheck(conn),
+		"mlmd-types":      health.MLMDTypesCheck(conn, mlmdTypeNamesConfig, typesMap),
+	}))
+	router.Handle(logLevelPath, logging.LevelHandler(logLevel, proxyCfg.LogLevelEndpoint))
+	router.Mount("/", apiHandler)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", cfg.Hostname, cfg.Port),
+		Handler:           router,
+		ReadTimeout:       proxyCfg.ReadTimeout,
+		ReadHeaderTimeout: proxyCfg.ReadHeaderTimeout,
+		WriteTimeout:      proxyCfg.WriteTimeout,
+		IdleTimeout:       proxyCfg.IdleTimeout,
+		ErrorLog:          slog.NewLogLogger(slog.Default().Handler(), slog.LevelError),
+	}
+	if proxyCfg.TLSCertFile != "" {
+		reloader, err := certs.NewReloader(proxyCfg.TLSCertFile, proxyCfg.TLSKeyFile, proxyCfg.TLSClientCAFile)
+		if err != nil {
+			return fmt.Errorf("error loading TLS certificates: %v", err)
+		}
+		server.TLSConfig = reloader.ServerConfig()
+	} else if proxyCfg.TLSKeyFile != "" || proxyCfg.TLSClientCAFile != "" {
+		return fmt.Errorf("--tls-key-file and --tls-client-ca-file require --tls-cert-file")
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		var err error
+		if server.TLSConfig != nil {
+			slog.Info("proxy server started", "address", server.Addr, "tls", true)
+			// certificates are served by the TLS config, which also reloads them when rotated
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			slog.Info("proxy server started", "address", server.Addr, "tls", false)
+			err = server.ListenAndServe()
+		}
+		serverErr <- err
+	}()
+
+	select {
+	case err := <-serverErr:
+		return fmt.Errorf("error serving proxy: %v", err)
+	case <-ctx.Done():
+	}
 
-	glog.Fatal(http.ListenAndServe(fmt.Sprintf("%s:%d", cfg.Hostname, cfg.Port), router))
+	// drain in-flight requests before the deferred close of the MLMD connection
+	slog.Info("shutting down proxy server, waiting for in-flight requests", "timeout", proxyCfg.ShutdownTimeout)
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), proxyCfg.ShutdownTimeout)
+	defer cancelShutdown()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("error shutting down proxy server: %v", err)
+	}
+	slog.Info("proxy server stopped")
 	return nil
 }
 
@@ -74,16 +273,163 @@ func init() {
 	proxyCmd.Flags().StringVarP(&cfg.Hostname, "hostname", "n", cfg.Hostname, "Proxy server listen hostname")
 	proxyCmd.Flags().IntVarP(&cfg.Port, "port", "p", cfg.Port, "Proxy server listen port")
 
-	proxyCmd.Flags().StringVar(&proxyCfg.MLMDHostname, "mlmd-hostname", proxyCfg.MLMDHostname, "MLMD hostname")
-	proxyCmd.Flags().IntVar(&proxyCfg.MLMDPort, "mlmd-port", proxyCfg.MLMDPort, "MLMD port")
+	addMLMDFlags(proxyCmd.Flags())
+	proxyCmd.Flags().BoolVar(&proxyCfg.SkipTypeCreation, "skip-type-creation", proxyCfg.SkipTypeCreation, "Only validate the existing MLMD types, without creating or updating them")
+
+	proxyCmd.Flags().BoolVar(&proxyCfg.MultiTenancy, "multi-tenancy", proxyCfg.MultiTenancy, "Confine every request to the tenant provided in the tenant header")
+	proxyCmd.Flags().StringVar(&proxyCfg.TenantHeader, "tenant-header", proxyCfg.TenantHeader, "Request header carrying the tenant when multi-tenancy is enabled")
+
+	proxyCmd.Flags().StringVar(&proxyCfg.TLSCertFile, "tls-cert-file", proxyCfg.TLSCertFile, "PEM certificate served over TLS, reloaded when rotated")
+	proxyCmd.Flags().StringVar(&proxyCfg.TLSKeyFile, "tls-key-file", proxyCfg.TLSKeyFile, "PEM private key of the TLS certificate, reloaded when rotated")
+	proxyCmd.Flags().StringVar(&proxyCfg.TLSClientCAFile, "tls-client-ca-file", proxyCfg.TLSClientCAFile, "PEM CA bundle used to verify client certificates, enables mTLS")
+
+	proxyCmd.Flags().DurationVar(&proxyCfg.ReadTimeout, "read-timeout", proxyCfg.ReadTimeout, "Maximum duration for reading an entire request, including the body, 0 disables it")
+	proxyCmd.Flags().DurationVar(&proxyCfg.ReadHeaderTimeout, "read-header-timeout", proxyCfg.ReadHeaderTimeout, "Maximum duration for reading request headers")
+	proxyCmd.Flags().DurationVar(&proxyCfg.WriteTimeout, "write-timeout", proxyCfg.WriteTimeout, "Maximum duration before timing out writes of the response, 0 disables it")
+	proxyCmd.Flags().DurationVar(&proxyCfg.IdleTimeout, "idle-timeout", proxyCfg.IdleTimeout, "Maximum duration to wait for the next request on keep-alive connections")
+	proxyCmd.Flags().DurationVar(&proxyCfg.ShutdownTimeout, "shutdown-timeout", proxyCfg.ShutdownTimeout, "Maximum duration to drain in-flight requests on SIGTERM")
+
+	proxyCmd.Flags().StringVar(&proxyCfg.Tracing.Exporter, "otel-exporter", proxyCfg.Tracing.Exporter, "OpenTelemetry trace exporter, one of none, otlp or stdout")
+	proxyCmd.Flags().StringVar(&proxyCfg.Tracing.Endpoint, "otel-endpoint", proxyCfg.Tracing.Endpoint, "OTLP gRPC collector endpoint, defaults to OTEL_EXPORTER_OTLP_ENDPOINT")
+	proxyCmd.Flags().BoolVar(&proxyCfg.Tracing.Insecure, "otel-insecure", proxyCfg.Tracing.Insecure, "Disable TLS towards the OTLP collector")
+	proxyCmd.Flags().Float64Var(&proxyCfg.Tracing.SampleRatio, "otel-sample-ratio", proxyCfg.Tracing.SampleRatio, "Fraction of root traces being sampled")
+
+	proxyCmd.Flags().BoolVar(&proxyCfg.LogLevelEndpoint, "log-level-endpoint", proxyCfg.LogLevelEndpoint, "Allow changing the log level at runtime with PUT "+logLevelPath+", which is unauthenticated, otherwise the endpoint is read-only")
+
+	proxyCmd.Flags().BoolVar(&proxyCfg.ValidationEnabled, "validation-enabled", proxyCfg.ValidationEnabled, "Validate entities before storing them, with the rules of the "+validationConfigKey+" config file key")
+	proxyCmd.Flags().StringVar(&proxyCfg.PropertySchemasFile, "property-schemas-file", proxyCfg.PropertySchemasFile, "YAML or JSON file listing the global custom property schemas")
+	proxyCmd.Flags().StringVar(&proxyCfg.ModelCardTemplatesDir, "model-card-templates-dir", proxyCfg.ModelCardTemplatesDir, "Directory of *.md.tmpl and *.html.tmpl templates overriding the default model card templates")
+
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.Type, "storage-type", proxyCfg.Storage.Type, "Storage backend of uploaded model artifacts, one of filesystem or s3, uploads are disabled when empty")
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.Path, "storage-path", proxyCfg.Storage.Path, "Root directory of the filesystem storage backend")
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.URIPrefix, "storage-uri-prefix", proxyCfg.Storage.URIPrefix, "Prefix of the URIs of uploaded model artifacts on the filesystem storage backend, e.g. pvc://models, file:// followed by --storage-path when empty")
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.Endpoint, "storage-s3-endpoint", proxyCfg.Storage.Endpoint, "URL of the S3 compatible service, e.g. http://minio:9000, AWS S3 when empty")
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.Region, "storage-s3-region", proxyCfg.Storage.Region, "S3 region, us-east-1 when empty")
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.Bucket, "storage-s3-bucket", proxyCfg.Storage.Bucket, "S3 bucket storing uploaded model artifacts")
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.Prefix, "storage-s3-prefix", proxyCfg.Storage.Prefix, "Prefix of the keys of uploaded S3 objects")
+	proxyCmd.Flags().BoolVar(&proxyCfg.Storage.PathStyle, "storage-s3-path-style", proxyCfg.Storage.PathStyle, "Address the S3 bucket in the URL path, as required by MinIO")
+	proxyCmd.Flags().StringVar(&proxyCfg.Storage.StorageKey, "storage-key", proxyCfg.Storage.StorageKey, "Name of the storage secret recorded as storageKey on uploaded model artifacts, used by model servers to download them")
+}
+
+// allowStoreSchemes adds the URI schemes of the stores to the allowed model artifact URI schemes, when restricted,
+// so that the URIs of the model artifacts uploaded to or served from them are always valid
+func allowStoreSchemes(schemes []string, stores []objectstore.Store) []string {
+	if len(schemes) == 0 {
+		return schemes
+	}
+	for _, store := range stores {
+		storeUri, err := url.Parse(store.URI("model"))
+		if err != nil || storeUri.Scheme == "" {
+			continue
+		}
+		if !slices.ContainsFunc(schemes, func(scheme string) bool { return strings.EqualFold(scheme, storeUri.Scheme) }) {
+			schemes = append(schemes, storeUri.Scheme)
+		}
+	}
+	return schemes
+}
+
+// readPropertySchemas reads the list of global custom property schemas from a YAML or JSON file.
+// The file isn't read through viper, which would lowercase the custom property keys.
+func readPropertySchemas(path string) ([]model.PropertySchema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var content any
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %v", path, err)
+	}
+	// the schemas are decoded from JSON, as their field names are only defined there
+	if data, err = json.Marshal(content); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %v", path, err)
+	}
+	var schemas []model.PropertySchema
+	if err := json.Unmarshal(data, &schemas); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %v", path, err)
+	}
+	return schemas, nil
+}
+
+// addMLMDFlags adds the flags configuring the MLMD connection and type names, shared by all commands connecting to MLMD
+func addMLMDFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&proxyCfg.MLMDHostname, "mlmd-hostname", proxyCfg.MLMDHostname, "MLMD hostname")
+	flags.IntVar(&proxyCfg.MLMDPort, "mlmd-port", proxyCfg.MLMDPort, "MLMD port")
+
+	flags.BoolVar(&proxyCfg.MLMD.TLS, "mlmd-tls", proxyCfg.MLMD.TLS, "Connect to MLMD over TLS")
+	flags.StringVar(&proxyCfg.MLMD.CAFile, "mlmd-ca-file", proxyCfg.MLMD.CAFile, "PEM CA bundle verifying the MLMD server certificate, system roots when empty")
+	flags.StringVar(&proxyCfg.MLMD.CertFile, "mlmd-cert-file", proxyCfg.MLMD.CertFile, "PEM client certificate presented to MLMD (mTLS), reloaded when rotated")
+	flags.StringVar(&proxyCfg.MLMD.KeyFile, "mlmd-key-file", proxyCfg.MLMD.KeyFile, "PEM client key presented to MLMD (mTLS), reloaded when rotated")
+	flags.StringVar(&proxyCfg.MLMD.ServerName, "mlmd-server-name", proxyCfg.MLMD.ServerName, "Server name used to verify the MLMD certificate, defaults to the MLMD hostname")
+	flags.DurationVar(&proxyCfg.MLMD.ConnectTimeout, "mlmd-connect-timeout", proxyCfg.MLMD.ConnectTimeout, "Timeout of each MLMD connection attempt")
+	flags.DurationVar(&proxyCfg.MLMD.StartupTimeout, "mlmd-startup-timeout", proxyCfg.MLMD.StartupTimeout, "Overall time spent retrying the MLMD connection at startup, 0 retries forever")
+	flags.DurationVar(&proxyCfg.MLMD.InitialBackoff, "mlmd-initial-backoff", proxyCfg.MLMD.InitialBackoff, "Delay before the first MLMD connection retry, doubled on every attempt")
+	flags.DurationVar(&proxyCfg.MLMD.MaxBackoff, "mlmd-max-backoff", proxyCfg.MLMD.MaxBackoff, "Maximum delay between MLMD connection retries")
+	flags.DurationVar(&proxyCfg.MLMD.CallTimeout, "mlmd-call-timeout", proxyCfg.MLMD.CallTimeout, "Deadline of every MLMD call, 0 disables it")
+	flags.IntVar(&proxyCfg.MLMD.RetryMaxAttempts, "mlmd-retry-max-attempts", proxyCfg.MLMD.RetryMaxAttempts, "Attempts of MLMD reads failing with UNAVAILABLE, 1 disables retries")
+	flags.DurationVar(&proxyCfg.MLMD.RetryInitialBackoff, "mlmd-retry-initial-backoff", proxyCfg.MLMD.RetryInitialBackoff, "Initial delay between MLMD read retries")
+	flags.DurationVar(&proxyCfg.MLMD.RetryMaxBackoff, "mlmd-retry-max-backoff", proxyCfg.MLMD.RetryMaxBackoff, "Maximum delay between MLMD read retries")
+	flags.DurationVar(&proxyCfg.MLMD.KeepaliveTime, "mlmd-keepalive-time", proxyCfg.MLMD.KeepaliveTime, "Ping MLMD after this much inactivity, 0 disables keepalive")
+	flags.DurationVar(&proxyCfg.MLMD.KeepaliveTimeout, "mlmd-keepalive-timeout", proxyCfg.MLMD.KeepaliveTimeout, "Close the MLMD connection when a keepalive ping is not acknowledged in time")
+
+	flags.StringVar(&proxyCfg.TypeNames.RegisteredModelTypeName, "registered-model-type-name", proxyCfg.TypeNames.RegisteredModelTypeName, "MLMD context type name of RegisteredModel")
+	flags.StringVar(&proxyCfg.TypeNames.ModelVersionTypeName, "model-version-type-name", proxyCfg.TypeNames.ModelVersionTypeName, "MLMD context type name of ModelVersion")
+	flags.StringVar(&proxyCfg.TypeNames.ModelArtifactTypeName, "model-artifact-type-name", proxyCfg.TypeNames.ModelArtifactTypeName, "MLMD artifact type name of ModelArtifact")
+	flags.StringVar(&proxyCfg.TypeNames.DocArtifactTypeName, "doc-artifact-type-name", proxyCfg.TypeNames.DocArtifactTypeName, "MLMD artifact type name of DocArtifact")
+	flags.StringVar(&proxyCfg.TypeNames.ServingEnvironmentTypeName, "serving-environment-type-name", proxyCfg.TypeNames.ServingEnvironmentTypeName, "MLMD context type name of ServingEnvironment")
+	flags.StringVar(&proxyCfg.TypeNames.InferenceServiceTypeName, "inference-service-type-name", proxyCfg.TypeNames.InferenceServiceTypeName, "MLMD context type name of InferenceService")
+	flags.StringVar(&proxyCfg.TypeNames.ServeModelTypeName, "serve-model-type-name", proxyCfg.TypeNames.ServeModelTypeName, "MLMD execution type name of ServeModel")
+	flags.StringVar(&proxyCfg.TypeNames.SchemaVersionTypeName, "schema-version-type-name", proxyCfg.TypeNames.SchemaVersionTypeName, "MLMD context type name recording the schema version")
+	flags.BoolVar(&proxyCfg.TypeNames.CanAddFields, "can-add-fields", proxyCfg.TypeNames.CanAddFields, "Allow adding properties to existing MLMD types when creating them")
 }
 
 type ProxyConfig struct {
-	MLMDHostname string
-	MLMDPort     int
+	MLMDHostname     string
+	MLMDPort         int
+	MLMD             mlmdconn.Config
+	TypeNames        mlmdtypes.MLMDTypeNamesConfig
+	SkipTypeCreation bool
+	MultiTenancy     bool
+	TenantHeader     string
+	Tracing          tracing.Config
+	LogLevelEndpoint bool
+
+	ValidationEnabled bool
+	Validation        validation.Config
+
+	PropertySchemasFile string
+
+	ModelCardTemplatesDir string
+
+	Storage            objectstore.Config
+	StorageCredentials []objectstore.Config
+
+	TLSCertFile     string
+	TLSKeyFile      string
+	TLSClientCAFile string
+
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	ShutdownTimeout   time.Duration
 }
 
 var proxyCfg = ProxyConfig{
 	MLMDHostname: "localhost",
 	MLMDPort:     9090,
+	MLMD:         mlmdconn.NewDefaultConfig(),
+	TypeNames:    mlmdtypes.NewMLMDTypeNamesConfigFromDefaults(),
+	MultiTenancy: false,
+	TenantHeader: openapi.DefaultTenantHeader,
+	Tracing: tracing.Config{
+		Exporter:    tracing.ExporterNone,
+		SampleRatio: 1,
+	},
+	LogLevelEndpoint:  false,
+	ValidationEnabled: true,
+	Validation:        validation.NewDefaultConfig(),
+	ReadHeaderTimeout: 10 * time.Second,
+	IdleTimeout:       120 * time.Second,
+	ShutdownTimeout:   30 * time.Second,
 }
@@ -2,10 +2,10 @@ package cmd
 
 import (
 	"errors"
-	"flag"
 	"fmt"
-	"github.com/golang/glog"
+	"github.com/kubeflow/model-registry/internal/logging"
 	"github.com/spf13/pflag"
+	"log/slog"
 	"os"
 	"strings"
 
@@ -15,6 +15,11 @@ import (
 
 var cfgFile string
 
+var logCfg = logging.NewDefaultConfig()
+
+// logLevel is the log level set up by initConfig, which can be changed at runtime
+var logLevel *slog.LevelVar
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "model-registry",
@@ -41,7 +46,8 @@ custom metadata libraries, exposing a higher level GraphQL API, RBAC, etc.`,
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
-		glog.Exitf("error: %v", err)
+		slog.Error("command failed", "error", err)
+		os.Exit(1)
 	}
 }
 
@@ -54,10 +60,8 @@ func init() {
 
 	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.model-registry.yaml)")
 
-	// default to logging to stderr
-	_ = flag.Set("logtostderr", "true")
-	// also add standard glog flags
-	rootCmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+	rootCmd.PersistentFlags().StringVar(&logCfg.Format, "log-format", logCfg.Format, "Log format, one of text or json")
+	rootCmd.PersistentFlags().StringVar(&logCfg.Level, "log-level", logCfg.Level, "Log level, one of debug, info, warn or error")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -65,7 +69,7 @@ func init() {
 
 }
 
-// initConfig reads in config file and ENV variables if set.
+// initConfig reads in config file and ENV variables if set, then sets up logging.
 func initConfig(cmd *cobra.Command) error {
 	if cfgFile != "" {
 		// Use config file from the flag.
@@ -88,8 +92,9 @@ func initConfig(cmd *cobra.Command) error {
 	viper.AutomaticEnv() // read in environment variables that match
 
 	// If a config file is found, read it in.
+	configFileUsed := ""
 	if err := viper.ReadInConfig(); err == nil {
-		glog.Info("using config file: ", viper.ConfigFileUsed())
+		configFileUsed = viper.ConfigFileUsed()
 	} else {
 		var configFileNotFoundError viper.ConfigFileNotFoundError
 		ok := errors.As(err, &configFileNotFoundError)
@@ -111,6 +116,16 @@ func initConfig(cmd *cobra.Command) error {
 			err = cmd.Flags().Set(name, fmt.Sprintf("%v", value))
 		}
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	logLevel, err = logging.Setup(logCfg, os.Stderr)
+	if err != nil {
+		return err
+	}
+	if configFileUsed != "" {
+		slog.Info("using config file", "file", configFileUsed)
+	}
+	return nil
 }
@@ -3,16 +3,32 @@ package main
 import (
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/kubeflow/model-registry/csi/pkg/storage"
 	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/signing"
 )
 
 const (
 	modelRegistryBaseUrlEnv     = "MODEL_REGISTRY_BASE_URL"
 	modelRegistrySchemeEnv      = "MODEL_REGISTRY_SCHEME"
+	modelRegistryTrustBundleEnv = "MODEL_REGISTRY_TRUST_BUNDLE"
+	modelRegistryCacheDirEnv    = "MODEL_REGISTRY_CACHE_DIR"
+	modelRegistryCacheSizeEnv   = "MODEL_REGISTRY_CACHE_MAX_SIZE"
+	inferenceServiceIdEnv       = "MODEL_REGISTRY_INFERENCE_SERVICE_ID"
+	modelRegistryTokenEnv       = "MODEL_REGISTRY_TOKEN"
+	modelRegistryTokenFileEnv   = "MODEL_REGISTRY_TOKEN_FILE"
+	modelRegistryCABundleEnv    = "MODEL_REGISTRY_CA_BUNDLE"
+	modelRegistryClientCertEnv  = "MODEL_REGISTRY_CLIENT_CERT"
+	modelRegistryClientKeyEnv   = "MODEL_REGISTRY_CLIENT_KEY"
+	modelRegistryTimeoutEnv     = "MODEL_REGISTRY_TIMEOUT"
+	modelRegistryRetriesEnv     = "MODEL_REGISTRY_RETRIES"
 	modelRegistryBaseUrlDefault = "localhost:8080"
 	modelRegistrySchemeDefault  = "http"
+	modelRegistryTimeoutDefault = 30 * time.Second
+	modelRegistryRetriesDefault = 3
 )
 
 func main() {
@@ -35,14 +51,69 @@ func main() {
 		scheme = modelRegistrySchemeDefault
 	}
 
+	clientConfig := storage.ClientConfig{
+		Host:                baseUrl,
+		Token:               os.Getenv(modelRegistryTokenEnv),
+		TokenFile:           os.Getenv(modelRegistryTokenFileEnv),
+		CAFile:              os.Getenv(modelRegistryCABundleEnv),
+		CertFile:            os.Getenv(modelRegistryClientCertEnv),
+		KeyFile:             os.Getenv(modelRegistryClientKeyEnv),
+		Timeout:             modelRegistryTimeoutDefault,
+		RetryMaxAttempts:    modelRegistryRetriesDefault + 1,
+		RetryInitialBackoff: time.Second,
+		RetryMaxBackoff:     30 * time.Second,
+	}
+	if timeout, ok := os.LookupEnv(modelRegistryTimeoutEnv); ok && timeout != "" {
+		var err error
+		if clientConfig.Timeout, err = time.ParseDuration(timeout); err != nil {
+			log.Fatalf("Error parsing %s: %v", modelRegistryTimeoutEnv, err)
+		}
+	}
+	if retries, ok := os.LookupEnv(modelRegistryRetriesEnv); ok && retries != "" {
+		retryCount, err := strconv.Atoi(retries)
+		if err != nil || retryCount < 0 {
+			log.Fatalf("Error parsing %s: expected a non-negative number of retries, got %s", modelRegistryRetriesEnv, retries)
+		}
+		clientConfig.RetryMaxAttempts = retryCount + 1
+	}
+	httpClient, err := storage.NewHTTPClient(clientConfig)
+	if err != nil {
+		log.Fatalf("Error initializing model registry client: %v", err)
+	}
+
 	cfg := openapi.NewConfiguration()
 	cfg.Host = baseUrl
 	cfg.Scheme = scheme
+	cfg.HTTPClient = httpClient
 	provider, err := storage.NewModelRegistryProvider(cfg)
 	if err != nil {
 		log.Fatalf("Error initiliazing model registry provider: %v", err)
 	}
 
+	if trustBundle, ok := os.LookupEnv(modelRegistryTrustBundleEnv); ok && trustBundle != "" {
+		provider.TrustBundle, err = signing.LoadTrustBundle(trustBundle)
+		if err != nil {
+			log.Fatalf("Error loading trust bundle: %v", err)
+		}
+		log.Printf("Verifying model artifact signatures with %d trusted keys", len(provider.TrustBundle))
+	}
+
+	if cacheDir, ok := os.LookupEnv(modelRegistryCacheDirEnv); ok && cacheDir != "" {
+		provider.Cache = &storage.Cache{Dir: cacheDir}
+		if maxSize, ok := os.LookupEnv(modelRegistryCacheSizeEnv); ok && maxSize != "" {
+			provider.Cache.MaxSize, err = storage.ParseSize(maxSize)
+			if err != nil {
+				log.Fatalf("Error parsing %s: %v", modelRegistryCacheSizeEnv, err)
+			}
+		}
+		log.Printf("Caching models in %s", cacheDir)
+	}
+
+	if inferenceServiceId, ok := os.LookupEnv(inferenceServiceIdEnv); ok && inferenceServiceId != "" {
+		provider.InferenceServiceId = inferenceServiceId
+		log.Printf("Reporting model loads to InferenceService %s", inferenceServiceId)
+	}
+
 	if err := provider.DownloadModel(destPath, "", sourceUri); err != nil {
 		log.Fatalf(err.Error())
 	}
@@ -0,0 +1,268 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+const (
+	// partialSuffix is the suffix of the cache directories of downloads not completed yet
+	partialSuffix = ".partial"
+	// lockSuffix is the suffix of the lock files of the entries, locked while they're downloaded or used
+	lockSuffix = ".lock"
+)
+
+// Cache is a local cache of downloaded model artifacts. Each entry is a directory named after the key of the
+// artifact, see cacheKey, holding the files downloaded to the model directory. Downloads go to a partial directory,
+// kept when they fail to be resumed by the next initialization, and renamed to the entry once complete and verified.
+type Cache struct {
+	// Dir is the directory of the cache entries
+	Dir string
+	// MaxSize is the total size in bytes of the entries above which the least recently used ones are evicted, 0 for
+	// no limit
+	MaxSize int64
+}
+
+// cacheKey returns the key of the files of artifact downloaded from registry as modelName, derived from the id of
+// the artifact and its digests, or its last update time when it has none. Artifacts with neither can't be cached.
+func cacheKey(registry string, modelName string, artifact *openapi.ModelArtifact) (string, bool) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n", registry, artifact.GetId(), modelName)
+	switch {
+	case len(artifact.Digests) > 0:
+		digests := make([]string, 0, len(artifact.Digests))
+		for _, digest := range artifact.Digests {
+			digests = append(digests, fmt.Sprintf("%s:%s:%s", strings.ToLower(digest.Algorithm), digest.GetPath(), strings.ToLower(digest.Value)))
+		}
+		sort.Strings(digests)
+		fmt.Fprintf(h, "digests:%s\n", strings.Join(digests, ","))
+	case artifact.LastUpdateTimeSinceEpoch != nil:
+		fmt.Fprintf(h, "lastUpdateTimeSinceEpoch:%s\n", *artifact.LastUpdateTimeSinceEpoch)
+	default:
+		return "", false
+	}
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// Fetch fills modelDir with the files of the entry key, calling download to fill its partial directory first when
+// the entry isn't cached yet. download must leave the partial directory empty when the downloaded files are invalid,
+// as the files it leaves are resumed by the next call. The entry is locked meanwhile, so that initializers sharing
+// the cache wait for each other rather than downloading the same entry at once.
+func (c *Cache) Fetch(key string, modelDir string, download func(dir string) error) error {
+	entry := filepath.Join(c.Dir, key)
+	lock, err := lockFile(entry+lockSuffix, syscall.LOCK_EX)
+	if err != nil {
+		return fmt.Errorf("error locking cache entry %s: %w", entry, err)
+	}
+	defer lock.Close()
+
+	if _, err := os.Stat(entry); err == nil {
+		log.Printf("Using cached model %s", entry)
+		touch(entry)
+		return linkTree(entry, modelDir)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error reading cache entry %s: %w", entry, err)
+	}
+
+	partial := entry + partialSuffix
+	if err := os.MkdirAll(partial, 0o755); err != nil {
+		return fmt.Errorf("error creating cache directory %s: %w", partial, err)
+	}
+	touch(partial)
+	if err := download(partial); err != nil {
+		return err
+	}
+	if err := os.Rename(partial, entry); err != nil {
+		return fmt.Errorf("error adding %s to the cache: %w", entry, err)
+	}
+	log.Printf("Cached model %s", entry)
+	if err := c.evict(key); err != nil {
+		log.Printf("Error evicting cache entries: %v", err)
+	}
+	return linkTree(entry, modelDir)
+}
+
+// lockFile opens file, creating it if needed, and locks it with flock(2) operation how, e.g. syscall.LOCK_EX. The
+// lock is released by closing the returned file.
+func lockFile(file string, how int) (*os.File, error) {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), how); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// evict removes the least recently used entries and partial downloads, other than the ones of key and the ones locked
+// by other initializers, until the size of the cache is at most MaxSize
+func (c *Cache) evict(key string) error {
+	if c.MaxSize <= 0 {
+		return nil
+	}
+	dirs, err := os.ReadDir(c.Dir)
+	if err != nil {
+		return err
+	}
+	type entry struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var entries []entry
+	var total int64
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		info, err := dir.Info()
+		if err != nil {
+			return err
+		}
+		size, err := dirSize(filepath.Join(c.Dir, dir.Name()))
+		if err != nil {
+			return err
+		}
+		total += size
+		if strings.TrimSuffix(dir.Name(), partialSuffix) != key {
+			entries = append(entries, entry{name: dir.Name(), size: size, modTime: info.ModTime()})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.Before(entries[j].modTime)
+	})
+	for _, e := range entries {
+		if total <= c.MaxSize {
+			break
+		}
+		removed, err := c.remove(e.name)
+		if err != nil {
+			return err
+		}
+		if removed {
+			log.Printf("Evicted cache entry %s of %d bytes", e.name, e.size)
+			total -= e.size
+		}
+	}
+	if total > c.MaxSize {
+		log.Printf("Cache size %d bytes is above its maximum size %d bytes", total, c.MaxSize)
+	}
+	return nil
+}
+
+// remove removes the cache directory name unless its entry is locked, being downloaded or used by another initializer
+func (c *Cache) remove(name string) (bool, error) {
+	lock, err := lockFile(filepath.Join(c.Dir, strings.TrimSuffix(name, partialSuffix)+lockSuffix), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		log.Printf("Skipping the eviction of cache entry %s, in use", name)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer lock.Close()
+	if err := os.RemoveAll(filepath.Join(c.Dir, name)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// touch marks the cache directory dir as used now, for the eviction of the least recently used ones
+func touch(dir string) {
+	now := time.Now()
+	if err := os.Chtimes(dir, now, now); err != nil {
+		log.Printf("Error updating the modification time of %s: %v", dir, err)
+	}
+}
+
+// dirSize returns the total size of the files under dir
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+// linkTree hard links the files under src to the same paths under dst, copying them when they can't be linked, e.g.
+// across file systems
+func linkTree(src string, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err := os.Link(path, target); err == nil {
+			return nil
+		}
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// ParseSize parses a size in bytes, optionally suffixed by one of the binary units Ki, Mi, Gi or Ti, e.g. 20Gi
+func ParseSize(size string) (int64, error) {
+	number, multiplier := size, int64(1)
+	for i, unit := range []string{"Ki", "Mi", "Gi", "Ti"} {
+		if strings.HasSuffix(size, unit) {
+			number = strings.TrimSuffix(size, unit)
+			multiplier = 1 << (10 * (i + 1))
+			break
+		}
+	}
+	value, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid size %s, expected a number of bytes optionally suffixed by Ki, Mi, Gi or Ti", size)
+	}
+	return value * multiplier, nil
+}
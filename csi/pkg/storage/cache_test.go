@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+func TestCacheFetch(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir()}
+	downloads := 0
+	download := func(content string) func(dir string) error {
+		return func(dir string) error {
+			downloads++
+			if err := os.MkdirAll(filepath.Join(dir, "mnist-v1"), 0o755); err != nil {
+				return err
+			}
+			return os.WriteFile(filepath.Join(dir, "mnist-v1", "model.onnx"), []byte(content), 0o600)
+		}
+	}
+	readModel := func(modelDir string) string {
+		content, err := os.ReadFile(filepath.Join(modelDir, "mnist-v1", "model.onnx"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content)
+	}
+
+	for i := 0; i < 2; i++ {
+		modelDir := t.TempDir()
+		if err := cache.Fetch("a", modelDir, download("weights")); err != nil {
+			t.Fatal(err)
+		}
+		if content := readModel(modelDir); content != "weights" {
+			t.Errorf("expected the model content weights, got %s", content)
+		}
+	}
+	if downloads != 1 {
+		t.Errorf("expected the model to be downloaded once, got %d downloads", downloads)
+	}
+
+	// failed downloads are kept to be resumed
+	err := cache.Fetch("b", t.TempDir(), func(dir string) error {
+		if err := os.WriteFile(filepath.Join(dir, "part"), []byte("wei"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return errors.New("connection reset")
+	})
+	if err == nil {
+		t.Fatal("expected the download error")
+	}
+	err = cache.Fetch("b", t.TempDir(), func(dir string) error {
+		if _, err := os.Stat(filepath.Join(dir, "part")); err != nil {
+			t.Errorf("expected the partial download to be kept: %v", err)
+		}
+		return os.Remove(filepath.Join(dir, "part"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(cache.Dir, "b"+partialSuffix)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected the partial download to be renamed to the entry, got %v", err)
+	}
+}
+
+func TestCacheEvict(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir(), MaxSize: 20}
+	fill := func(dir string) error {
+		return os.WriteFile(filepath.Join(dir, "model.bin"), make([]byte, 10), 0o600)
+	}
+	for _, key := range []string{"a", "b"} {
+		if err := cache.Fetch(key, t.TempDir(), fill); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a is used after b, b is the least recently used entry
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(cache.Dir, "b"), past, past); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Fetch("a", t.TempDir(), fill); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Fetch("c", t.TempDir(), fill); err != nil {
+		t.Fatal(err)
+	}
+	for key, cached := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, err := os.Stat(filepath.Join(cache.Dir, key)); (err == nil) != cached {
+			t.Errorf("expected entry %s to be cached %t, got %v", key, cached, err)
+		}
+	}
+}
+
+func TestCacheFetchConcurrent(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir()}
+	var downloads atomic.Int32
+	download := func(dir string) error {
+		downloads.Add(1)
+		// an interleaved download would find the file of another one
+		if _, err := os.Stat(filepath.Join(dir, "model.onnx")); err == nil {
+			return errors.New("partial directory shared with another download")
+		}
+		time.Sleep(20 * time.Millisecond)
+		return os.WriteFile(filepath.Join(dir, "model.onnx"), []byte("weights"), 0o600)
+	}
+
+	errs := make(chan error, 4)
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			errs <- cache.Fetch("a", t.TempDir(), download)
+		}()
+	}
+	for i := 0; i < cap(errs); i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+	if downloads.Load() != 1 {
+		t.Errorf("expected the model to be downloaded once, got %d downloads", downloads.Load())
+	}
+}
+
+func TestCacheEvictLocked(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir(), MaxSize: 10}
+	// b is being downloaded by another initializer
+	partial := filepath.Join(cache.Dir, "b"+partialSuffix)
+	if err := os.MkdirAll(partial, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(partial, "model.bin"), make([]byte, 10), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	lock, err := lockFile(filepath.Join(cache.Dir, "b"+lockSuffix), syscall.LOCK_EX)
+	if err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(partial, past, past); err != nil {
+		t.Fatal(err)
+	}
+
+	fill := func(dir string) error {
+		return os.WriteFile(filepath.Join(dir, "model.bin"), make([]byte, 10), 0o600)
+	}
+	if err := cache.Fetch("a", t.TempDir(), fill); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(partial); err != nil {
+		t.Errorf("expected the locked partial download to be kept, got %v", err)
+	}
+
+	// once unlocked, it's evicted
+	if err := lock.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Fetch("c", t.TempDir(), fill); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(partial); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected the unlocked partial download to be evicted, got %v", err)
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	id := "1"
+	labels := "labels.txt"
+	digests := []openapi.ArtifactDigest{{Algorithm: "sha256", Value: sha256Hex("weights")}, {Algorithm: "sha256", Value: sha256Hex("cat"), Path: &labels}}
+	key, ok := cacheKey("registry:8080", "mnist-v1", &openapi.ModelArtifact{Id: &id, Digests: digests})
+	if !ok {
+		t.Fatal("expected artifacts with digests to be cached")
+	}
+	reordered, _ := cacheKey("registry:8080", "mnist-v1", &openapi.ModelArtifact{Id: &id, Digests: []openapi.ArtifactDigest{digests[1], digests[0]}})
+	if key != reordered {
+		t.Error("expected the key not to depend on the order of the digests")
+	}
+	otherRegistry, _ := cacheKey("other:8080", "mnist-v1", &openapi.ModelArtifact{Id: &id, Digests: digests})
+	if key == otherRegistry {
+		t.Error("expected the key to depend on the registry")
+	}
+
+	updated, updatedAgain := "1700000000000", "1700000001000"
+	first, ok := cacheKey("registry:8080", "mnist-v1", &openapi.ModelArtifact{Id: &id, LastUpdateTimeSinceEpoch: &updated})
+	if !ok {
+		t.Fatal("expected artifacts with a last update time to be cached")
+	}
+	second, _ := cacheKey("registry:8080", "mnist-v1", &openapi.ModelArtifact{Id: &id, LastUpdateTimeSinceEpoch: &updatedAgain})
+	if first == second {
+		t.Error("expected the key to change with the last update time")
+	}
+
+	if _, ok := cacheKey("registry:8080", "mnist-v1", &openapi.ModelArtifact{Id: &id}); ok {
+		t.Error("expected artifacts without digests nor last update time not to be cached")
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	for size, expected := range map[string]int64{"0": 0, "1024": 1024, "20Gi": 20 << 30, "512Mi": 512 << 20} {
+		actual, err := ParseSize(size)
+		if err != nil || actual != expected {
+			t.Errorf("expected %s to be %d bytes, got %d, %v", size, expected, actual, err)
+		}
+	}
+	for _, size := range []string{"", "Gi", "-1", "20GB"} {
+		if _, err := ParseSize(size); err == nil {
+			t.Errorf("expected an error parsing %s", size)
+		}
+	}
+}
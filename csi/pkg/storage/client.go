@@ -0,0 +1,226 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ClientConfig configures the HTTP client the storage initializer uses to reach the model registry
+type ClientConfig struct {
+	Host      string // host[:port] of the model registry, the only host bearer tokens are sent to
+	Token     string // optional, static bearer token
+	TokenFile string // optional, file holding the bearer token, e.g. a projected service account token, re-read when rotated
+	CAFile    string // optional, CA bundle used to verify the model registry certificate
+	CertFile  string // optional, client certificate presented to the model registry (mTLS)
+	KeyFile   string // optional, key of the client certificate
+
+	Timeout             time.Duration // timeout of each request attempt, 0 means no timeout
+	RetryMaxAttempts    int           // attempts of requests failing with transient errors, 0 or 1 disables retries
+	RetryInitialBackoff time.Duration // backoff before the first retry, doubled on each retry
+	RetryMaxBackoff     time.Duration // maximum backoff between retries, 0 means no maximum
+}
+
+// NewHTTPClient creates an HTTP client for the model registry, adding the bearer token to its requests, verifying its
+// certificate with the CA bundle, presenting the client certificate and retrying transient errors
+func NewHTTPClient(cfg ClientConfig) (*http.Client, error) {
+	if cfg.Token != "" && cfg.TokenFile != "" {
+		return nil, fmt.Errorf("bearer token and token file are mutually exclusive")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.CAFile != "" || cfg.CertFile != "" || cfg.KeyFile != "" {
+		config, err := tlsConfig(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error loading model registry TLS material: %w", err)
+		}
+		transport.TLSClientConfig = config
+	}
+
+	var next http.RoundTripper = transport
+	if cfg.Token != "" || cfg.TokenFile != "" {
+		token := &bearerToken{token: cfg.Token, file: cfg.TokenFile}
+		if _, err := token.get(); err != nil {
+			return nil, err
+		}
+		next = &bearerTransport{host: cfg.Host, token: token, next: next}
+	}
+	return &http.Client{Transport: &retryTransport{
+		timeout:        cfg.Timeout,
+		maxAttempts:    cfg.RetryMaxAttempts,
+		initialBackoff: cfg.RetryInitialBackoff,
+		maxBackoff:     cfg.RetryMaxBackoff,
+		next:           next,
+	}}, nil
+}
+
+// bearerToken is a static token or the content of a token file, re-read whenever its modification time changes.
+// When a rotated file cannot be read the previous token keeps being used.
+type bearerToken struct {
+	token string
+	file  string
+
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+func (b *bearerToken) get() (string, error) {
+	if b.file == "" {
+		return b.token, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	info, err := os.Stat(b.file)
+	if err == nil && info.ModTime().Equal(b.modTime) {
+		return b.token, nil
+	}
+	if err == nil {
+		var content []byte
+		content, err = os.ReadFile(b.file)
+		if err == nil {
+			b.token, b.modTime = strings.TrimSpace(string(content)), info.ModTime()
+			return b.token, nil
+		}
+	}
+	if b.token == "" {
+		return "", fmt.Errorf("error reading bearer token file %s: %w", b.file, err)
+	}
+	log.Printf("Error reloading bearer token file %s, keeping the previous token: %v", b.file, err)
+	return b.token, nil
+}
+
+// bearerTransport authorizes requests to host with the bearer token, requests to other hosts, e.g. other registries
+// selected with the registry URI option, are sent without it
+type bearerTransport struct {
+	host  string
+	token *bearerToken
+	next  http.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.host {
+		return t.next.RoundTrip(req)
+	}
+	token, err := t.token.get()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.next.RoundTrip(req)
+}
+
+// retryTransport times out each request attempt and retries the attempts failing with transient errors with an
+// exponential backoff, honoring the Retry-After header of the responses. Network errors, 502 and 504 responses are
+// only retried for idempotent requests, as the request may have been processed.
+type retryTransport struct {
+	timeout        time.Duration
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	next           http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.initialBackoff
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+		resp, err := t.roundTrip(attemptReq)
+		if attempt >= t.maxAttempts || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := backoff
+		if resp != nil {
+			if wait = retryAfter(resp, wait); t.maxBackoff > 0 && wait > t.maxBackoff {
+				wait = t.maxBackoff
+			}
+			// drain the body to reuse the connection
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			_ = resp.Body.Close()
+			log.Printf("Retrying %s %s in %s after response %s", req.Method, req.URL.Redacted(), wait, resp.Status)
+		} else {
+			log.Printf("Retrying %s %s in %s after error: %v", req.Method, req.URL.Redacted(), wait, err)
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		if backoff = 2 * backoff; t.maxBackoff > 0 && backoff > t.maxBackoff {
+			backoff = t.maxBackoff
+		}
+	}
+}
+
+// roundTrip sends a single attempt, canceling its timeout once the response body is closed
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions ||
+		req.Method == http.MethodPut || req.Method == http.MethodDelete
+	if err != nil {
+		var netErr net.Error
+		return idempotent && (errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, context.DeadlineExceeded))
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+	return false
+}
+
+// retryAfter returns the delay of the Retry-After header of resp in seconds, fallback when there is none
+func retryAfter(resp *http.Response, fallback time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return fallback
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// hashes creates the hash of each supported digest algorithm
+var hashes = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// verifyDigests checks the files downloaded to modelDir, the {modelDir}/{modelName} directory of the kserve providers,
+// against the digests recorded on the model artifact. Digests with a path refer to the file at that path relative to
+// modelDir, the one without path to the single file the artifact URI points to, downloaded with its base name, or as
+// modelDir itself by the S3 provider. When digests have paths, every file of modelDir must have one, so that no file
+// is added to the model artifact.
+func verifyDigests(modelDir string, artifact *openapi.ModelArtifact) error {
+	listed := map[string]bool{}
+	for _, digest := range artifact.Digests {
+		if digest.GetPath() != "" {
+			listed[path.Clean(digest.GetPath())] = true
+		}
+
+		newHash, ok := hashes[digest.Algorithm]
+		if !ok {
+			return fmt.Errorf("unsupported digest algorithm %s for model artifact %s", digest.Algorithm, artifact.GetId())
+		}
+
+		name := digest.GetPath()
+		if name == "" {
+			uri, err := url.Parse(artifact.GetUri())
+			if err != nil {
+				return fmt.Errorf("invalid URI of model artifact %s: %w", artifact.GetId(), err)
+			}
+			name = path.Base(uri.Path)
+		}
+		file := filepath.Join(modelDir, filepath.FromSlash(name))
+		if !strings.HasPrefix(file, filepath.Clean(modelDir)+string(filepath.Separator)) {
+			return fmt.Errorf("digest path %s of model artifact %s is outside the model directory", name, artifact.GetId())
+		}
+		if digest.GetPath() == "" {
+			if info, err := os.Stat(modelDir); err == nil && info.Mode().IsRegular() {
+				file = modelDir
+			}
+		}
+
+		actual, err := fileDigest(file, newHash())
+		if err != nil {
+			return fmt.Errorf("error verifying %s digest of %s: %w", digest.Algorithm, name, err)
+		}
+		if !strings.EqualFold(actual, digest.Value) {
+			return fmt.Errorf("integrity check failed for %s of model artifact %s: expected %s digest %s, got %s", name, artifact.GetId(), digest.Algorithm, strings.ToLower(digest.Value), actual)
+		}
+	}
+	if len(listed) == 0 {
+		return nil
+	}
+	return filepath.WalkDir(modelDir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if file == modelDir || entry.IsDir() {
+			return nil
+		}
+		relative, err := filepath.Rel(modelDir, file)
+		if err != nil {
+			return err
+		}
+		if name := filepath.ToSlash(relative); !listed[name] {
+			return fmt.Errorf("integrity check failed for model artifact %s: file %s has no digest", artifact.GetId(), name)
+		}
+		return nil
+	})
+}
+
+// fileDigest returns the hex encoded digest of the content of file computed with h
+func fileDigest(file string, h hash.Hash) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
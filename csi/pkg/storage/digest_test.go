@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestVerifyDigests(t *testing.T) {
+	modelDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(modelDir, "config"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{"model.onnx": "weights", "config/labels.txt": "cat\ndog\n"} {
+		if err := os.WriteFile(filepath.Join(modelDir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	id := "1"
+	labels := "config/labels.txt"
+	weights := "model.onnx"
+	uri := "https://example.com/models/model.onnx"
+
+	testCases := []struct {
+		name    string
+		digests []openapi.ArtifactDigest
+		err     string
+	}{
+		{
+			name: "no digests",
+		},
+		{
+			name: "manifest",
+			digests: []openapi.ArtifactDigest{
+				{Path: &weights, Algorithm: "sha256", Value: sha256Hex("weights")},
+				{Path: &labels, Algorithm: "sha256", Value: strings.ToUpper(sha256Hex("cat\ndog\n"))},
+			},
+		},
+		{
+			name: "file without digest",
+			digests: []openapi.ArtifactDigest{
+				{Path: &labels, Algorithm: "sha256", Value: sha256Hex("cat\ndog\n")},
+			},
+			err: "integrity check failed for model artifact 1: file model.onnx has no digest",
+		},
+		{
+			name:    "single file",
+			digests: []openapi.ArtifactDigest{{Algorithm: "sha256", Value: sha256Hex("weights")}},
+		},
+		{
+			name:    "mismatch",
+			digests: []openapi.ArtifactDigest{{Path: &labels, Algorithm: "sha256", Value: sha256Hex("cat\n")}},
+			err:     "integrity check failed for config/labels.txt of model artifact 1: expected sha256 digest " + sha256Hex("cat\n") + ", got " + sha256Hex("cat\ndog\n"),
+		},
+		{
+			name:    "missing file",
+			digests: []openapi.ArtifactDigest{{Path: &id, Algorithm: "sha256", Value: sha256Hex("")}},
+			err:     "error verifying sha256 digest of 1",
+		},
+		{
+			name:    "unsupported algorithm",
+			digests: []openapi.ArtifactDigest{{Algorithm: "md5", Value: "00"}},
+			err:     "unsupported digest algorithm md5",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifyDigests(modelDir, &openapi.ModelArtifact{Id: &id, Uri: &uri, Digests: tc.digests})
+			if tc.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyDigestsSingleFileModelDir(t *testing.T) {
+	// the S3 provider downloads the single object of a key prefix as the model directory itself
+	modelDir := filepath.Join(t.TempDir(), "mnist-v1")
+	if err := os.WriteFile(modelDir, []byte("weights"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	id := "1"
+	uri := "s3://models/mnist/model.onnx"
+	digests := []openapi.ArtifactDigest{{Algorithm: "sha256", Value: sha256Hex("weights")}}
+	if err := verifyDigests(modelDir, &openapi.ModelArtifact{Id: &id, Uri: &uri, Digests: digests}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestVerifyDigestsExtraFiles(t *testing.T) {
+	id := "1"
+	uri := "https://example.com/models/mnist"
+	weights := "model.onnx"
+	digests := []openapi.ArtifactDigest{{Path: &weights, Algorithm: "sha256", Value: sha256Hex("weights")}}
+
+	testCases := []struct {
+		name  string
+		extra func(modelDir string) error
+		err   string
+	}{
+		{
+			name: "added file",
+			extra: func(modelDir string) error {
+				return os.WriteFile(filepath.Join(modelDir, "loader.py"), []byte("import os"), 0o600)
+			},
+			err: "file loader.py has no digest",
+		},
+		{
+			name:  "symbolic link",
+			extra: func(modelDir string) error { return os.Symlink("/etc/passwd", filepath.Join(modelDir, "config.json")) },
+			err:   "file config.json has no digest",
+		},
+		{
+			name:  "empty directory",
+			extra: func(modelDir string) error { return os.Mkdir(filepath.Join(modelDir, "cache"), 0o755) },
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			modelDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(modelDir, "model.onnx"), []byte("weights"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if err := tc.extra(modelDir); err != nil {
+				t.Fatal(err)
+			}
+			err := verifyDigests(modelDir, &openapi.ModelArtifact{Id: &id, Uri: &uri, Digests: digests})
+			if tc.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
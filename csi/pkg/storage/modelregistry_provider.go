@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
 	kserve "github.com/kserve/kserve/pkg/agent/storage"
 	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/signing"
 )
 
 const MR kserve.Protocol = "model-registry://"
@@ -16,6 +20,13 @@ const MR kserve.Protocol = "model-registry://"
 type ModelRegistryProvider struct {
 	Client    *openapi.APIClient
 	Providers map[kserve.Protocol]kserve.Provider
+	// TrustBundle, when set, restricts downloads to model artifacts signed by one of its keys
+	TrustBundle signing.TrustBundle
+	// Cache, when set, keeps the downloaded model artifacts to reuse them and resumes partial HTTP(S) downloads
+	Cache *Cache
+	// InferenceServiceId, when set, is the InferenceService whose ServeModel of the resolved model version and
+	// artifact is created or updated after each download, see report
+	InferenceServiceId string
 }
 
 func NewModelRegistryProvider(cfg *openapi.Configuration) (*ModelRegistryProvider, error) {
@@ -29,68 +40,35 @@ func NewModelRegistryProvider(cfg *openapi.Configuration) (*ModelRegistryProvide
 
 var _ kserve.Provider = (*ModelRegistryProvider)(nil)
 
-// storageUri formatted like model-registry://{registeredModelName}/{versionName}
+// DownloadModel downloads the model artifact storageUri refers to, formatted like
+// model-registry://{registeredModelName}[/{versionName}][?{options}], see modelRegistryUri for the options
 func (p *ModelRegistryProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
 	log.Printf("Download model indexed in model registry: modelName=%s, storageUri=%s, modelDir=%s", modelName, storageUri, modelDir)
 
 	// Parse the URI to retrieve the needed information to query model registry (modelArtifact)
-	mrUri := strings.TrimPrefix(storageUri, string(MR))
-	tokens := strings.SplitN(mrUri, "/", 2)
-
-	if len(tokens) == 0 || len(tokens) > 2 {
-		return fmt.Errorf("invalid model registry URI, use like model-registry://{registeredModelName}/{versionName}")
-	}
-
-	registeredModelName := tokens[0]
-	var versionName *string
-	if len(tokens) == 2 {
-		versionName = &tokens[1]
-	}
-
-	// Fetch the registered model
-	model, _, err := p.Client.ModelRegistryServiceAPI.FindRegisteredModel(context.Background()).Name(registeredModelName).Execute()
+	uri, err := parseModelRegistryUri(storageUri)
 	if err != nil {
 		return err
 	}
-
-	// Fetch model version by name or latest if not specified
-	var version *openapi.ModelVersion
-	if versionName != nil {
-		version, _, err = p.Client.ModelRegistryServiceAPI.FindModelVersion(context.Background()).Name(*versionName).ParentResourceID(*model.Id).Execute()
-		if err != nil {
-			return err
-		}
-	} else {
-		versions, _, err := p.Client.ModelRegistryServiceAPI.GetRegisteredModelVersions(context.Background(), *model.Id).
-			OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
-			SortOrder(openapi.SORTORDER_DESC).
-			Execute()
-		if err != nil {
-			return err
-		}
-
-		if versions.Size == 0 {
-			return fmt.Errorf("no versions associated to registered model %s", registeredModelName)
-		}
-		version = &versions.Items[0]
-	}
-
-	artifacts, _, err := p.Client.ModelRegistryServiceAPI.GetModelVersionArtifacts(context.Background(), *version.Id).
-		OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
-		SortOrder(openapi.SORTORDER_DESC).
-		Execute()
+	resolved, err := p.resolve(context.Background(), uri)
 	if err != nil {
 		return err
 	}
+	version, modelArtifact := resolved.version, resolved.artifact
+	log.Printf("Resolved model version %s (%s) and model artifact %s (%s)", version.GetId(), version.GetName(), modelArtifact.GetId(), modelArtifact.GetName())
 
-	if artifacts.Size == 0 {
-		return fmt.Errorf("no artifacts associated to model version %s", *version.Id)
+	err = p.downloadResolved(modelDir, uri, resolved)
+	if p.InferenceServiceId != "" {
+		if err := p.report(context.Background(), storageUri, resolved, err); err != nil {
+			log.Printf("Error reporting the model load to InferenceService %s: %v", p.InferenceServiceId, err)
+		}
 	}
+	return err
+}
 
-	modelArtifact := artifacts.Items[0].ModelArtifact
-	if modelArtifact == nil {
-		return fmt.Errorf("no model artifact found for model version %s", *version.Id)
-	}
+// downloadResolved downloads the resolved model artifact of uri to modelDir
+func (p *ModelRegistryProvider) downloadResolved(modelDir string, uri *modelRegistryUri, resolved *resolved) error {
+	version, modelArtifact := resolved.version, resolved.artifact
 
 	// Call appropriate kserve provider based on the indexed model artifact URI
 	if modelArtifact.Uri == nil {
@@ -102,17 +80,70 @@ func (p *ModelRegistryProvider) DownloadModel(modelDir string, modelName string,
 		return err
 	}
 
-	provider, err := kserve.GetProvider(p.Providers, protocol)
-	if err != nil {
-		return err
+	if p.TrustBundle != nil {
+		if err := p.verifySignatures(resolved.client, modelArtifact); err != nil {
+			return err
+		}
 	}
 
-	modelName = registeredModelName
+	modelName := uri.RegisteredModelName
 	if version.Name != nil {
 		modelName = fmt.Sprintf("%s-%s", modelName, *version.Name)
 	}
 
-	return provider.DownloadModel(modelDir, modelName, *modelArtifact.Uri)
+	download := func(dir string) error {
+		if err := p.fetch(dir, modelName, protocol, *modelArtifact.Uri); err != nil {
+			return err
+		}
+		return verifyModel(filepath.Join(dir, modelName), modelArtifact)
+	}
+	if p.Cache == nil {
+		return download(modelDir)
+	}
+	key, ok := cacheKey(resolved.client.GetConfig().Host, modelName, modelArtifact)
+	if !ok {
+		log.Printf("Model artifact %s has neither digests nor last update time, downloading it without cache", *modelArtifact.Id)
+		return download(modelDir)
+	}
+	return p.Cache.Fetch(key, modelDir, func(dir string) error {
+		if err := p.fetch(dir, modelName, protocol, *modelArtifact.Uri); err != nil {
+			return err
+		}
+		if err := verifyModel(filepath.Join(dir, modelName), modelArtifact); err != nil {
+			// invalid content isn't resumed
+			if err := os.RemoveAll(dir); err != nil {
+				log.Printf("Error removing the download of model artifact %s: %v", *modelArtifact.Id, err)
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// fetch downloads the model artifact at artifactUri to modelDir with the kserve provider of protocol, or resuming
+// partial HTTP(S) downloads when the model artifacts are cached
+func (p *ModelRegistryProvider) fetch(modelDir string, modelName string, protocol kserve.Protocol, artifactUri string) error {
+	provider, err := kserve.GetProvider(p.Providers, protocol)
+	if err != nil {
+		return err
+	}
+	if p.Cache != nil && (protocol == kserve.HTTP || protocol == kserve.HTTPS) {
+		client := http.DefaultClient
+		if httpsProvider, ok := provider.(*kserve.HTTPSProvider); ok && httpsProvider.Client != nil {
+			client = httpsProvider.Client
+		}
+		provider = &resumableHTTPSProvider{Client: client}
+	}
+	return provider.DownloadModel(modelDir, modelName, artifactUri)
+}
+
+// verifyModel verifies the files downloaded to modelDir, i.e. {modelDir}/{modelName} of the kserve providers, against the digests of the model artifact, if any
+func verifyModel(modelDir string, modelArtifact *openapi.ModelArtifact) error {
+	if len(modelArtifact.Digests) == 0 {
+		log.Printf("Model artifact %s has no digests, skipping integrity verification", *modelArtifact.Id)
+		return nil
+	}
+	return verifyDigests(modelDir, modelArtifact)
 }
 
 func extractProtocol(storageURI string) (kserve.Protocol, error) {
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kserve "github.com/kserve/kserve/pkg/agent/storage"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+func TestDownloadModelVerifiesDigests(t *testing.T) {
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/models/model.onnx" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("weights"))
+	}))
+	defer files.Close()
+	registry := &fakeRegistry{
+		versions:  []openapi.ModelVersion{{Id: of("1"), Name: of("v1"), RegisteredModelId: "1"}},
+		artifacts: map[string][]openapi.ModelArtifact{},
+	}
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := openapi.NewConfiguration()
+	cfg.Host = serverUrl.Host
+	cfg.Scheme = serverUrl.Scheme
+
+	testCases := []struct {
+		name   string
+		digest string
+		cached bool
+		err    string
+	}{
+		{
+			name:   "matching digest",
+			digest: sha256Hex("weights"),
+		},
+		{
+			name:   "matching digest, cached",
+			digest: sha256Hex("weights"),
+			cached: true,
+		},
+		{
+			name:   "mismatch",
+			digest: sha256Hex("tampered"),
+			err:    "integrity check failed for model.onnx of model artifact 10",
+		},
+		{
+			name:   "mismatch, cached",
+			digest: sha256Hex("tampered"),
+			cached: true,
+			err:    "integrity check failed for model.onnx of model artifact 10",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry.artifacts["1"] = []openapi.ModelArtifact{{
+				Id:      of("10"),
+				Name:    of("model"),
+				Uri:     of(files.URL + "/models/model.onnx"),
+				Digests: []openapi.ArtifactDigest{{Algorithm: "sha256", Value: tc.digest}},
+			}}
+			provider, err := NewModelRegistryProvider(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider.Providers[kserve.HTTP] = &kserve.HTTPSProvider{Client: files.Client()}
+			if tc.cached {
+				provider.Cache = &Cache{Dir: t.TempDir()}
+			}
+			modelDir := t.TempDir()
+
+			err = provider.DownloadModel(modelDir, "", "model-registry://mnist/v1")
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Errorf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			content, err := os.ReadFile(filepath.Join(modelDir, "mnist-v1", "model.onnx"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != "weights" {
+				t.Errorf("expected the downloaded model content, got %q", content)
+			}
+		})
+	}
+}
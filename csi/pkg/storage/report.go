@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// Custom properties of the ServeModel reported after downloads
+const (
+	storageUriProperty       = "storageUri"
+	modelArtifactIdProperty  = "modelArtifactId"
+	modelArtifactUriProperty = "modelArtifactUri"
+	errorProperty            = "error"
+)
+
+// serveModelName returns the name of the ServeModel of the resolved model version and artifact
+func serveModelName(resolved *resolved) string {
+	return fmt.Sprintf("model-version-%s-artifact-%s", resolved.version.GetId(), resolved.artifact.GetId())
+}
+
+// report creates or updates the ServeModel of the resolved model version and artifact in the InferenceService
+// InferenceServiceId of the registry the model was resolved from. Its lastKnownState is RUNNING when the model was
+// downloaded, FAILED with the error otherwise, and its custom properties record the storageUri requested and the model
+// artifact downloaded.
+func (p *ModelRegistryProvider) report(ctx context.Context, storageUri string, resolved *resolved, downloadErr error) error {
+	state := openapi.EXECUTIONSTATE_RUNNING
+	properties := map[string]openapi.MetadataValue{
+		storageUriProperty:       stringValue(storageUri),
+		modelArtifactIdProperty:  stringValue(resolved.artifact.GetId()),
+		modelArtifactUriProperty: stringValue(resolved.artifact.GetUri()),
+	}
+	if downloadErr != nil {
+		state = openapi.EXECUTIONSTATE_FAILED
+		properties[errorProperty] = stringValue(downloadErr.Error())
+	}
+
+	name := serveModelName(resolved)
+	existing, err := findServeModel(ctx, resolved.client, p.InferenceServiceId, name)
+	if err != nil {
+		return err
+	}
+	var serveModel *openapi.ServeModel
+	if existing == nil {
+		serveModel, _, err = resolved.client.ModelRegistryServiceAPI.CreateInferenceServiceServe(ctx, p.InferenceServiceId).ServeModelCreate(openapi.ServeModelCreate{
+			Name:             &name,
+			ModelVersionId:   resolved.version.GetId(),
+			LastKnownState:   &state,
+			CustomProperties: &properties,
+		}).Execute()
+	} else {
+		serveModel, _, err = resolved.client.ModelRegistryServiceAPI.UpdateInferenceServiceServe(ctx, p.InferenceServiceId, existing.GetId()).ServeModelUpdate(openapi.ServeModelUpdate{
+			LastKnownState:   &state,
+			CustomProperties: &properties,
+		}).Execute()
+	}
+	if err != nil {
+		return fmt.Errorf("error reporting ServeModel %s: %w", name, err)
+	}
+	log.Printf("Reported ServeModel %s (%s) of InferenceService %s as %s", serveModel.GetId(), name, p.InferenceServiceId, state)
+	return nil
+}
+
+// findServeModel returns the ServeModel named name of the InferenceService, nil when there is none
+func findServeModel(ctx context.Context, client *openapi.APIClient, inferenceServiceId string, name string) (*openapi.ServeModel, error) {
+	pageToken := ""
+	for {
+		request := client.ModelRegistryServiceAPI.GetInferenceServiceServes(ctx, inferenceServiceId).PageSize(pageSize)
+		if pageToken != "" {
+			request = request.NextPageToken(pageToken)
+		}
+		serveModels, _, err := request.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error listing ServeModels of InferenceService %s: %w", inferenceServiceId, err)
+		}
+		for i := range serveModels.Items {
+			if serveModels.Items[i].GetName() == name {
+				return &serveModels.Items[i], nil
+			}
+		}
+		if serveModels.NextPageToken == "" || len(serveModels.Items) == 0 {
+			return nil, nil
+		}
+		pageToken = serveModels.NextPageToken
+	}
+}
+
+func stringValue(value string) openapi.MetadataValue {
+	return openapi.MetadataStringValueAsMetadataValue(openapi.NewMetadataStringValue(value, "MetadataStringValue"))
+}
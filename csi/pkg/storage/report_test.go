@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// fakeServes serves the ServeModels of InferenceService 5
+type fakeServes struct {
+	serveModels []openapi.ServeModel
+}
+
+func (f *fakeServes) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == apiPrefix+"/inference_services/5/serves":
+		_ = json.NewEncoder(w).Encode(openapi.ServeModelList{Items: f.serveModels, Size: int32(len(f.serveModels))})
+	case r.Method == http.MethodPost && r.URL.Path == apiPrefix+"/inference_services/5/serves":
+		var create openapi.ServeModelCreate
+		if err := json.NewDecoder(r.Body).Decode(&create); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.serveModels = append(f.serveModels, openapi.ServeModel{
+			Id:               of("9"),
+			Name:             create.Name,
+			ModelVersionId:   create.ModelVersionId,
+			LastKnownState:   create.LastKnownState,
+			CustomProperties: create.CustomProperties,
+		})
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(f.serveModels[len(f.serveModels)-1])
+	case r.Method == http.MethodPatch && r.URL.Path == apiPrefix+"/inference_services/5/serves/9":
+		var update openapi.ServeModelUpdate
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.serveModels[0].LastKnownState = update.LastKnownState
+		f.serveModels[0].CustomProperties = update.CustomProperties
+		_ = json.NewEncoder(w).Encode(f.serveModels[0])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestReport(t *testing.T) {
+	serves := &fakeServes{}
+	server := httptest.NewServer(serves)
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := openapi.NewConfiguration()
+	cfg.Host = serverUrl.Host
+	cfg.Scheme = serverUrl.Scheme
+	provider, err := NewModelRegistryProvider(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.InferenceServiceId = "5"
+	resolved := &resolved{
+		client:   provider.Client,
+		version:  &openapi.ModelVersion{Id: of("2"), Name: of("v1")},
+		artifact: &openapi.ModelArtifact{Id: of("3"), Uri: of("s3://models/mnist/v1")},
+	}
+	storageUri := "model-registry://mnist/v1"
+
+	if err := provider.report(context.Background(), storageUri, resolved, errors.New("access denied")); err != nil {
+		t.Fatal(err)
+	}
+	if len(serves.serveModels) != 1 {
+		t.Fatalf("expected a ServeModel to be created, got %d", len(serves.serveModels))
+	}
+	serveModel := serves.serveModels[0]
+	if serveModel.GetName() != "model-version-2-artifact-3" || serveModel.ModelVersionId != "2" || serveModel.GetLastKnownState() != openapi.EXECUTIONSTATE_FAILED {
+		t.Errorf("unexpected ServeModel %+v", serveModel)
+	}
+	if properties := serveModel.GetCustomProperties(); properties[errorProperty].MetadataStringValue.GetStringValue() != "access denied" ||
+		properties[storageUriProperty].MetadataStringValue.GetStringValue() != storageUri ||
+		properties[modelArtifactIdProperty].MetadataStringValue.GetStringValue() != "3" {
+		t.Errorf("unexpected ServeModel custom properties %+v", properties)
+	}
+
+	// the same model loaded again updates the ServeModel
+	if err := provider.report(context.Background(), storageUri, resolved, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(serves.serveModels) != 1 {
+		t.Fatalf("expected the ServeModel to be updated, got %d ServeModels", len(serves.serveModels))
+	}
+	serveModel = serves.serveModels[0]
+	if serveModel.GetLastKnownState() != openapi.EXECUTIONSTATE_RUNNING {
+		t.Errorf("expected the ServeModel to be RUNNING, got %s", serveModel.GetLastKnownState())
+	}
+	if _, ok := serveModel.GetCustomProperties()[errorProperty]; ok {
+		t.Error("expected the error of the failed load to be removed")
+	}
+}
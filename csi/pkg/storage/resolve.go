@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// pageSize is the size of the pages of versions and artifacts listed while resolving URIs
+const pageSize = "100"
+
+// resolved is the registered model, model version and model artifact a model-registry:// URI refers to
+type resolved struct {
+	client   *openapi.APIClient
+	model    *openapi.RegisteredModel
+	version  *openapi.ModelVersion
+	artifact *openapi.ModelArtifact
+}
+
+// clientFor returns the client of registry, host[:port] optionally prefixed by a scheme, or the configured client when
+// registry is empty. Other registries are called with the same settings as the configured one.
+func (p *ModelRegistryProvider) clientFor(registry string) (*openapi.APIClient, error) {
+	if registry == "" {
+		return p.Client, nil
+	}
+	cfg := *p.Client.GetConfig()
+	scheme, host, found := strings.Cut(registry, "://")
+	if !found {
+		scheme, host = cfg.Scheme, registry
+	}
+	if host == "" || strings.ContainsAny(host, "/?#") || scheme != "http" && scheme != "https" {
+		return nil, fmt.Errorf("invalid registry %s, expected host[:port] optionally prefixed by http:// or https://", registry)
+	}
+	cfg.Host = host
+	cfg.Scheme = scheme
+	return openapi.NewAPIClient(&cfg), nil
+}
+
+// resolve finds the registered model, model version and model artifact uri refers to
+func (p *ModelRegistryProvider) resolve(ctx context.Context, uri *modelRegistryUri) (*resolved, error) {
+	client, err := p.clientFor(uri.Registry)
+	if err != nil {
+		return nil, err
+	}
+	model, _, err := client.ModelRegistryServiceAPI.FindRegisteredModel(ctx).Name(uri.RegisteredModelName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error finding registered model %s: %w", uri.RegisteredModelName, err)
+	}
+	version, err := resolveVersion(ctx, client, uri, model)
+	if err != nil {
+		return nil, err
+	}
+	artifact, err := resolveArtifact(ctx, client, uri, version)
+	if err != nil {
+		return nil, err
+	}
+	return &resolved{client: client, model: model, version: version, artifact: artifact}, nil
+}
+
+// resolveVersion finds the model version of model selected by uri, checking that it is in the state of uri
+func resolveVersion(ctx context.Context, client *openapi.APIClient, uri *modelRegistryUri, model *openapi.RegisteredModel) (*openapi.ModelVersion, error) {
+	var version *openapi.ModelVersion
+	var err error
+	switch {
+	case uri.VersionId != "":
+		version, _, err = client.ModelRegistryServiceAPI.GetModelVersion(ctx, uri.VersionId).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error getting model version %s: %w", uri.VersionId, err)
+		}
+		if version.RegisteredModelId != model.GetId() {
+			return nil, fmt.Errorf("model version %s is not a version of registered model %s", uri.VersionId, uri.RegisteredModelName)
+		}
+	case uri.VersionName != "":
+		version, _, err = client.ModelRegistryServiceAPI.FindModelVersion(ctx).Name(uri.VersionName).ParentResourceId(model.GetId()).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error finding version %s of registered model %s: %w", uri.VersionName, uri.RegisteredModelName, err)
+		}
+	default:
+		// the latest version in state, with the alias if any
+		version, err = findVersion(ctx, client, model.GetId(), func(version *openapi.ModelVersion) bool {
+			return versionState(version) == uri.State && (uri.Alias == "" || versionAlias(version) == uri.Alias)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if version == nil && uri.Alias != "" {
+			return nil, fmt.Errorf("no %s version of registered model %s has alias %s", uri.State, uri.RegisteredModelName, uri.Alias)
+		}
+		if version == nil {
+			return nil, fmt.Errorf("no %s versions associated to registered model %s", uri.State, uri.RegisteredModelName)
+		}
+	}
+	if state := versionState(version); state != uri.State {
+		return nil, fmt.Errorf("model version %s of registered model %s is %s, set the state option to use it", version.GetName(), uri.RegisteredModelName, state)
+	}
+	return version, nil
+}
+
+// findVersion returns the latest version of a registered model matching match, nil when none does
+func findVersion(ctx context.Context, client *openapi.APIClient, registeredModelId string, match func(*openapi.ModelVersion) bool) (*openapi.ModelVersion, error) {
+	pageToken := ""
+	for {
+		request := client.ModelRegistryServiceAPI.GetRegisteredModelVersions(ctx, registeredModelId).
+			PageSize(pageSize).
+			OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
+			SortOrder(openapi.SORTORDER_DESC)
+		if pageToken != "" {
+			request = request.NextPageToken(pageToken)
+		}
+		versions, _, err := request.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error listing versions of registered model %s: %w", registeredModelId, err)
+		}
+		for i := range versions.Items {
+			if match(&versions.Items[i]) {
+				return &versions.Items[i], nil
+			}
+		}
+		if versions.NextPageToken == "" || len(versions.Items) == 0 {
+			return nil, nil
+		}
+		pageToken = versions.NextPageToken
+	}
+}
+
+// resolveArtifact finds the latest LIVE model artifact of version named as in uri, if set, preferring the ones of the
+// model format of uri
+func resolveArtifact(ctx context.Context, client *openapi.APIClient, uri *modelRegistryUri, version *openapi.ModelVersion) (*openapi.ModelArtifact, error) {
+	var candidates []*openapi.ModelArtifact
+	pageToken := ""
+	for {
+		request := client.ModelRegistryServiceAPI.GetModelVersionArtifacts(ctx, version.GetId()).
+			PageSize(pageSize).
+			OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
+			SortOrder(openapi.SORTORDER_DESC)
+		if uri.ArtifactName != "" {
+			request = request.Name(uri.ArtifactName)
+		}
+		if pageToken != "" {
+			request = request.NextPageToken(pageToken)
+		}
+		artifacts, _, err := request.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error listing artifacts of model version %s: %w", version.GetId(), err)
+		}
+		for _, artifact := range artifacts.Items {
+			modelArtifact := artifact.ModelArtifact
+			if modelArtifact == nil || uri.ArtifactName != "" && modelArtifact.GetName() != uri.ArtifactName {
+				continue
+			}
+			// artifacts without state are the ones registered before states were set, and are used
+			if modelArtifact.State != nil && *modelArtifact.State != openapi.ARTIFACTSTATE_LIVE {
+				log.Printf("Skipping model artifact %s of model version %s in state %s", modelArtifact.GetId(), version.GetId(), *modelArtifact.State)
+				continue
+			}
+			if uri.ModelFormatName == "" || strings.EqualFold(modelArtifact.GetModelFormatName(), uri.ModelFormatName) {
+				return modelArtifact, nil
+			}
+			candidates = append(candidates, modelArtifact)
+		}
+		if artifacts.NextPageToken == "" || len(artifacts.Items) == 0 {
+			break
+		}
+		pageToken = artifacts.NextPageToken
+	}
+
+	if len(candidates) == 0 {
+		if uri.ArtifactName != "" {
+			return nil, fmt.Errorf("no LIVE model artifact named %s found for model version %s", uri.ArtifactName, version.GetId())
+		}
+		return nil, fmt.Errorf("no LIVE model artifact found for model version %s", version.GetId())
+	}
+	log.Printf("No model artifact of format %s found for model version %s, using model artifact %s of format %s", uri.ModelFormatName, version.GetId(), candidates[0].GetId(), candidates[0].GetModelFormatName())
+	return candidates[0], nil
+}
+
+// versionState returns the state of version, LIVE when not set
+func versionState(version *openapi.ModelVersion) openapi.ModelVersionState {
+	if version.State == nil {
+		return openapi.MODELVERSIONSTATE_LIVE
+	}
+	return *version.State
+}
+
+// versionAlias returns the alias custom property of version, empty when not set
+func versionAlias(version *openapi.ModelVersion) string {
+	alias, ok := version.GetCustomProperties()[aliasProperty]
+	if !ok || alias.MetadataStringValue == nil {
+		return ""
+	}
+	return alias.MetadataStringValue.StringValue
+}
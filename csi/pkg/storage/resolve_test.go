@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+const apiPrefix = "/api/model_registry/v1alpha3"
+
+// fakeRegistry serves the registered model mnist with versions, newest first, and their model artifacts
+type fakeRegistry struct {
+	versions  []openapi.ModelVersion
+	artifacts map[string][]openapi.ModelArtifact
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	query := r.URL.Query()
+	path := strings.TrimPrefix(r.URL.Path, apiPrefix)
+	switch {
+	case path == "/registered_model" && query.Get("name") == "mnist":
+		_ = json.NewEncoder(w).Encode(openapi.RegisteredModel{Id: of("1"), Name: of("mnist")})
+	case path == "/registered_models/1/versions":
+		// one version per page, to go through the pages
+		page := 0
+		if token := query.Get("nextPageToken"); token != "" {
+			page = int(token[0] - '0')
+		}
+		list := openapi.ModelVersionList{Items: f.versions[page : page+1], Size: 1}
+		if page+1 < len(f.versions) {
+			list.NextPageToken = string(rune('0' + page + 1))
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case path == "/model_version" && query.Get("parentResourceId") == "1":
+		for _, version := range f.versions {
+			if version.GetName() == query.Get("name") {
+				_ = json.NewEncoder(w).Encode(version)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	case strings.HasPrefix(path, "/model_versions/") && strings.HasSuffix(path, "/artifacts"):
+		list := openapi.ArtifactList{Items: []openapi.Artifact{}}
+		for _, artifact := range f.artifacts[strings.Split(path, "/")[2]] {
+			if name := query.Get("name"); name == "" || artifact.GetName() == name {
+				artifact := artifact
+				artifact.ArtifactType = "model-artifact"
+				list.Items = append(list.Items, openapi.ModelArtifactAsArtifact(&artifact))
+			}
+		}
+		list.Size = int32(len(list.Items))
+		_ = json.NewEncoder(w).Encode(list)
+	case strings.HasPrefix(path, "/model_versions/"):
+		for _, version := range f.versions {
+			if version.GetId() == strings.TrimPrefix(path, "/model_versions/") {
+				_ = json.NewEncoder(w).Encode(version)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func of[E any](e E) *E {
+	return &e
+}
+
+func TestResolve(t *testing.T) {
+	archived := openapi.MODELVERSIONSTATE_ARCHIVED
+	pending := openapi.ARTIFACTSTATE_PENDING
+	production := map[string]openapi.MetadataValue{
+		aliasProperty: openapi.MetadataStringValueAsMetadataValue(openapi.NewMetadataStringValue("production", "MetadataStringValue")),
+	}
+	registry := &fakeRegistry{
+		versions: []openapi.ModelVersion{
+			{Id: of("4"), Name: of("v4"), RegisteredModelId: "1", State: &archived},
+			{Id: of("3"), Name: of("v3"), RegisteredModelId: "1"},
+			{Id: of("2"), Name: of("v2"), RegisteredModelId: "1", CustomProperties: &production},
+		},
+		artifacts: map[string][]openapi.ModelArtifact{
+			"4": {{Id: of("40"), Name: of("model"), Uri: of("s3://models/v4")}},
+			"3": {
+				{Id: of("32"), Name: of("model"), Uri: of("s3://models/v3/pending"), State: &pending},
+				{Id: of("31"), Name: of("model"), Uri: of("s3://models/v3/model.onnx"), ModelFormatName: of("onnx")},
+				{Id: of("30"), Name: of("model-torch"), Uri: of("s3://models/v3/model.pt"), ModelFormatName: of("pytorch")},
+			},
+			"2": {{Id: of("20"), Name: of("model"), Uri: of("s3://models/v2")}},
+		},
+	}
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := openapi.NewConfiguration()
+	cfg.Host = "registry.invalid:8080"
+	cfg.Scheme = serverUrl.Scheme
+	provider, err := NewModelRegistryProvider(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the configured registry can't be reached, all the URIs set the one of the test server
+	registryOption := "registry=" + serverUrl.Host
+
+	testCases := []struct {
+		uri      string
+		version  string
+		artifact string
+		err      string
+	}{
+		{uri: "model-registry://mnist?" + registryOption, version: "3", artifact: "31"},
+		{uri: "model-registry://mnist/v2?" + registryOption, version: "2", artifact: "20"},
+		{uri: "model-registry://mnist?versionId=2&" + registryOption, version: "2", artifact: "20"},
+		{uri: "model-registry://mnist?alias=production&" + registryOption, version: "2", artifact: "20"},
+		{uri: "model-registry://mnist?state=archived&" + registryOption, version: "4", artifact: "40"},
+		{uri: "model-registry://mnist/v3?format=pytorch&" + registryOption, version: "3", artifact: "30"},
+		{uri: "model-registry://mnist/v3?format=tensorflow&" + registryOption, version: "3", artifact: "31"},
+		{uri: "model-registry://mnist/v3?artifact=model-torch&" + registryOption, version: "3", artifact: "30"},
+		{uri: "model-registry://mnist?registry=" + server.URL, version: "3", artifact: "31"},
+		{uri: "model-registry://mnist/v4?" + registryOption, err: "model version v4 of registered model mnist is ARCHIVED"},
+		{uri: "model-registry://mnist?alias=staging&" + registryOption, err: "no LIVE version of registered model mnist has alias staging"},
+		{uri: "model-registry://mnist/v3?artifact=model-tf&" + registryOption, err: "no LIVE model artifact named model-tf"},
+		{uri: "model-registry://mnist?registry=ftp://" + serverUrl.Host, err: "invalid registry"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.uri, func(t *testing.T) {
+			uri, err := parseModelRegistryUri(tc.uri)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resolved, err := provider.resolve(context.Background(), uri)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Errorf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resolved.version.GetId() != tc.version || resolved.artifact.GetId() != tc.artifact {
+				t.Errorf("expected version %s and artifact %s, got %s and %s", tc.version, tc.artifact, resolved.version.GetId(), resolved.artifact.GetId())
+			}
+		})
+	}
+}
+
+func TestParseModelRegistryUri(t *testing.T) {
+	uri, err := parseModelRegistryUri("model-registry://my%20model/v1/rc1?artifact=weights&format=onnx&registry=https://registry.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := modelRegistryUri{
+		RegisteredModelName: "my model",
+		VersionName:         "v1/rc1",
+		State:               openapi.MODELVERSIONSTATE_LIVE,
+		ArtifactName:        "weights",
+		ModelFormatName:     "onnx",
+		Registry:            "https://registry.example.com",
+	}
+	if *uri != expected {
+		t.Errorf("expected %+v, got %+v", expected, *uri)
+	}
+
+	for _, invalid := range []string{
+		"s3://models/mnist",
+		"model-registry://",
+		"model-registry://mnist/v1?versionId=1",
+		"model-registry://mnist?versionId=1&alias=production",
+		"model-registry://mnist?state=DELETED",
+		"model-registry://mnist?artifact=a&artifact=b",
+		"model-registry://mnist?version=v1",
+	} {
+		if _, err := parseModelRegistryUri(invalid); err == nil {
+			t.Errorf("expected an error parsing %s", invalid)
+		}
+	}
+}
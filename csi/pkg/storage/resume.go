@@ -0,0 +1,268 @@
+package storage
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	kserve "github.com/kserve/kserve/pkg/agent/storage"
+)
+
+const (
+	// downloadDir is the directory of the model directory holding the content of an HTTP(S) download in progress
+	downloadDir  = ".download"
+	contentFile  = "content"
+	responseFile = "response.json"
+)
+
+// resumableHTTPSProvider downloads HTTP(S) model artifacts like the kserve provider, writing the files to
+// {modelDir}/{modelName}, extracting zip and tar archives, and sending the headers set in the {hostname}-headers
+// environment variable. Partial downloads are kept in the model directory and resumed by the next download.
+type resumableHTTPSProvider struct {
+	Client *http.Client
+}
+
+var _ kserve.Provider = (*resumableHTTPSProvider)(nil)
+
+// downloadResponse is the response the content being downloaded comes from, to resume it only if it didn't change
+type downloadResponse struct {
+	// Validator is the ETag, or Last-Modified header when there is no strong ETag
+	Validator   string `json:"validator"`
+	ContentType string `json:"contentType"`
+}
+
+func (p *resumableHTTPSProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
+	log.Printf("Download model with resume: modelName=%s, storageUri=%s, modelDir=%s", modelName, storageUri, modelDir)
+	uri, err := url.Parse(storageUri)
+	if err != nil {
+		return fmt.Errorf("unable to parse storage uri: %v", err)
+	}
+	download := filepath.Join(modelDir, downloadDir)
+	if err := os.MkdirAll(download, 0o755); err != nil {
+		return err
+	}
+	contentType, err := p.fetch(storageUri, uri.Hostname(), download)
+	if err != nil {
+		return err
+	}
+
+	content := filepath.Join(download, contentFile)
+	fileDirectory := filepath.Join(modelDir, modelName)
+	switch {
+	case strings.Contains(contentType, "application/zip"):
+		err = extractZip(content, fileDirectory)
+	case strings.Contains(contentType, "application/x-tar") || strings.Contains(contentType, "application/x-gtar") ||
+		strings.Contains(contentType, "application/x-gzip") || strings.Contains(contentType, "application/gzip"):
+		err = extractTar(content, fileDirectory)
+	default:
+		if err = os.MkdirAll(fileDirectory, 0o755); err == nil {
+			err = os.Rename(content, filepath.Join(fileDirectory, path.Base(uri.Path)))
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(download)
+}
+
+// fetch downloads storageUri to the content file of download, resuming the content already downloaded when the
+// server supports ranges and the content didn't change. It returns the content type of the content.
+func (p *resumableHTTPSProvider) fetch(storageUri string, hostname string, download string) (string, error) {
+	content := filepath.Join(download, contentFile)
+	responsePath := filepath.Join(download, responseFile)
+	var previous downloadResponse
+	var offset int64
+	if b, err := os.ReadFile(responsePath); err == nil {
+		if err := json.Unmarshal(b, &previous); err == nil && previous.Validator != "" {
+			if info, err := os.Stat(content); err == nil {
+				offset = info.Size()
+			}
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, storageUri, nil)
+	if err != nil {
+		return "", err
+	}
+	var headers map[string]string
+	if headerJSON, ok := os.LookupEnv(hostname + kserve.HEADER_SUFFIX); ok {
+		if err := json.Unmarshal([]byte(headerJSON), &headers); err != nil {
+			log.Printf("Error parsing the headers of %s: %v", hostname, err)
+		}
+	}
+	for key, value := range headers {
+		req.Header.Add(key, value)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		req.Header.Set("If-Range", previous.Validator)
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to make a request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0 && strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)):
+		log.Printf("Resuming the download of %s at byte %d", storageUri, offset)
+		flags |= os.O_APPEND
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0 && resp.Header.Get("Content-Range") == fmt.Sprintf("bytes */%d", offset):
+		log.Printf("Download of %s already complete", storageUri)
+		return previous.ContentType, nil
+	case resp.StatusCode == http.StatusOK:
+		flags |= os.O_TRUNC
+		// the response is recorded before its content, so that only content of this response is resumed
+		response := downloadResponse{Validator: resp.Header.Get("ETag"), ContentType: resp.Header.Get("Content-Type")}
+		if response.Validator == "" || strings.HasPrefix(response.Validator, "W/") {
+			response.Validator = resp.Header.Get("Last-Modified")
+		}
+		b, err := json.Marshal(response)
+		if err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(responsePath, b, 0o600); err != nil {
+			return "", err
+		}
+	default:
+		if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			// the content downloaded doesn't match the one of the response, start again on the next download
+			_ = os.Remove(responsePath)
+		}
+		return "", fmt.Errorf("URI: %s returned a %d response code", storageUri, resp.StatusCode)
+	}
+
+	file, err := os.OpenFile(content, flags, 0o600)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		file.Close()
+		return "", fmt.Errorf("unable to copy file content: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+	if flags&os.O_APPEND != 0 {
+		return previous.ContentType, nil
+	}
+	return resp.Header.Get("Content-Type"), nil
+}
+
+// extractZip extracts the zip archive to dest
+func extractZip(archive string, dest string) error {
+	r, err := zip.OpenReader(archive)
+	if err != nil {
+		return fmt.Errorf("unable to create new reader: %v", err)
+	}
+	defer r.Close()
+	for _, file := range r.File {
+		if file.Mode().IsDir() {
+			if err := extractDir(dest, file.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("unable to open file: %v", err)
+		}
+		err = extractFile(dest, file.Name, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractTar extracts the tar archive to dest, gzipped or not, e.g. the tar archives of directories served by the
+// model registry content endpoint aren't compressed
+func extractTar(archive string, dest string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer gzr.Close()
+		r = gzr
+	}
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to access next tar file: %v", err)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = extractDir(dest, header.Name)
+		case tar.TypeReg:
+			err = extractFile(dest, header.Name, tr)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// archivePath returns the path of name, an archive member, under dest
+func archivePath(dest string, name string) (string, error) {
+	fullPath := filepath.Join(dest, name)
+	if !strings.HasPrefix(fullPath, filepath.Clean(dest)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("%s: illegal file path", fullPath)
+	}
+	return fullPath, nil
+}
+
+func extractDir(dest string, name string) error {
+	dir, err := archivePath(dest, name)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(dir, 0o755)
+}
+
+func extractFile(dest string, name string, r io.Reader) error {
+	fullPath, err := archivePath(dest, name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return err
+	}
+	file, err := os.Create(fullPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return fmt.Errorf("unable to copy contents to %s: %v", name, err)
+	}
+	return file.Close()
+}
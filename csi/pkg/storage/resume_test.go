@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// flakyServer serves content with ServeContent, supporting ranges, aborting the first response after half of it when
+// aborted isn't set
+type flakyServer struct {
+	content     []byte
+	etag        string
+	contentType string
+	aborted     bool
+	ranges      []string
+}
+
+func (s *flakyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.ranges = append(s.ranges, r.Header.Get("Range"))
+	w.Header().Set("ETag", s.etag)
+	w.Header().Set("Content-Type", s.contentType)
+	if !s.aborted {
+		s.aborted = true
+		w.Header().Set("Content-Length", strconv.Itoa(len(s.content)))
+		_, _ = w.Write(s.content[:len(s.content)/2])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(s.content))
+}
+
+func TestResumableDownload(t *testing.T) {
+	content := bytes.Repeat([]byte("weights-"), 1000)
+	server := &flakyServer{content: content, etag: `"v1"`, contentType: "application/octet-stream"}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	provider := &resumableHTTPSProvider{Client: httpServer.Client()}
+	modelDir := t.TempDir()
+
+	if err := provider.DownloadModel(modelDir, "mnist-v1", httpServer.URL+"/models/model.bin"); err == nil {
+		t.Fatal("expected the aborted download to fail")
+	}
+	if err := provider.DownloadModel(modelDir, "mnist-v1", httpServer.URL+"/models/model.bin"); err != nil {
+		t.Fatal(err)
+	}
+	actual, err := os.ReadFile(filepath.Join(modelDir, "mnist-v1", "model.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual, content) {
+		t.Errorf("expected the downloaded content to be the served one, got %d bytes", len(actual))
+	}
+	if expected := []string{"", "bytes=4000-"}; strings.Join(server.ranges, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected requested ranges %v, got %v", expected, server.ranges)
+	}
+	if _, err := os.Stat(filepath.Join(modelDir, downloadDir)); !os.IsNotExist(err) {
+		t.Errorf("expected the download directory to be removed, got %v", err)
+	}
+}
+
+func TestResumableDownloadChanged(t *testing.T) {
+	server := &flakyServer{content: bytes.Repeat([]byte("v1"), 1000), etag: `"v1"`, contentType: "application/octet-stream"}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	provider := &resumableHTTPSProvider{Client: httpServer.Client()}
+	modelDir := t.TempDir()
+
+	if err := provider.DownloadModel(modelDir, "mnist-v1", httpServer.URL+"/model.bin"); err == nil {
+		t.Fatal("expected the aborted download to fail")
+	}
+	// the content changes, the partial download is discarded
+	server.content, server.etag = bytes.Repeat([]byte("v2"), 1000), `"v2"`
+	if err := provider.DownloadModel(modelDir, "mnist-v1", httpServer.URL+"/model.bin"); err != nil {
+		t.Fatal(err)
+	}
+	actual, err := os.ReadFile(filepath.Join(modelDir, "mnist-v1", "model.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual, server.content) {
+		t.Errorf("expected the downloaded content to be the new one, got %d bytes", len(actual))
+	}
+}
+
+func TestResumableDownloadArchive(t *testing.T) {
+	var archive bytes.Buffer
+	gzw := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gzw)
+	for name, content := range map[string]string{"1/model.onnx": "weights", "1/labels.txt": "cat\ndog\n"} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	server := &flakyServer{content: archive.Bytes(), etag: `"v1"`, contentType: "application/gzip", aborted: true}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	provider := &resumableHTTPSProvider{Client: httpServer.Client()}
+	modelDir := t.TempDir()
+	if err := provider.DownloadModel(modelDir, "mnist-v1", httpServer.URL+"/model.tar.gz"); err != nil {
+		t.Fatal(err)
+	}
+	actual, err := os.ReadFile(filepath.Join(modelDir, "mnist-v1", "1", "model.onnx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != "weights" {
+		t.Errorf("expected the extracted model content weights, got %s", actual)
+	}
+}
+
+func TestResumableDownloadRegistryContent(t *testing.T) {
+	// the uncompressed tar archive the registry content endpoint serves for a directory, see
+	// TestWriteTarStorageInitializer of internal/server/openapi
+	archive, err := os.ReadFile(filepath.Join("testdata", "content.tar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &flakyServer{content: archive, etag: `"v1"`, contentType: "application/x-tar", aborted: true}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	provider := &resumableHTTPSProvider{Client: httpServer.Client()}
+	modelDir := t.TempDir()
+	if err := provider.DownloadModel(modelDir, "mnist-v1", httpServer.URL+"/api/model_registry/v1alpha3/model_artifacts/3/content"); err != nil {
+		t.Fatal(err)
+	}
+	for name, expected := range map[string]string{"model.onnx": "weights", "config/labels.txt": "cat\ndog\n"} {
+		actual, err := os.ReadFile(filepath.Join(modelDir, "mnist-v1", filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != expected {
+			t.Errorf("expected the extracted content of %s to be %q, got %q", name, expected, actual)
+		}
+	}
+}
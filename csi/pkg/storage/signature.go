@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/signing"
+)
+
+// verifySignatures checks that the model artifact, read with client, is signed by a key of the trust bundle over its
+// current digests, before anything is downloaded. The downloaded files are then checked against those digests, see verifyDigests.
+func (p *ModelRegistryProvider) verifySignatures(client *openapi.APIClient, artifact *openapi.ModelArtifact) error {
+	signatures, _, err := client.ModelRegistryServiceAPI.GetModelArtifactSignatures(context.Background(), *artifact.Id).Execute()
+	if err != nil {
+		return err
+	}
+	signature, err := verifyArtifact(p.TrustBundle, artifact, signatures.Items)
+	if err != nil {
+		return err
+	}
+	log.Printf("Model artifact %s signed by key %s (%s)", *artifact.Id, signature.KeyId, signature.GetSigner())
+	return nil
+}
+
+// verifyArtifact returns the signature of artifact made by a key of bundle over its current digests, if any
+func verifyArtifact(bundle signing.TrustBundle, artifact *openapi.ModelArtifact, signatures []openapi.ArtifactSignature) (*openapi.ArtifactSignature, error) {
+	signature, err := bundle.VerifyArtifact(artifact.Digests, signatures)
+	if err != nil {
+		return nil, fmt.Errorf("signature verification failed for model artifact %s: %w", *artifact.Id, err)
+	}
+	return signature, nil
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/signing"
+)
+
+func TestVerifySignatures(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundlePath := filepath.Join(t.TempDir(), "trusted.pem")
+	if err := os.WriteFile(bundlePath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := signing.LoadTrustBundle(bundlePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyId, err := signing.KeyID(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := "1"
+	digests := []openapi.ArtifactDigest{{Algorithm: "sha256", Value: sha256Hex("weights")}}
+	manifest, err := signing.Manifest(digests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := signing.Sign(privateKey, manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := openapi.ArtifactSignature{KeyId: keyId, Algorithm: signing.Ed25519, Signature: base64.StdEncoding.EncodeToString(signed)}
+
+	var signatures []openapi.ArtifactSignature
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/model_registry/v1alpha3/model_artifacts/1/signatures" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(openapi.NewArtifactSignatureList(signatures, int32(len(signatures))))
+	}))
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := openapi.NewConfiguration()
+	cfg.Host = serverUrl.Host
+	cfg.Scheme = serverUrl.Scheme
+	provider, err := NewModelRegistryProvider(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.TrustBundle = bundle
+
+	testCases := []struct {
+		name       string
+		digests    []openapi.ArtifactDigest
+		signatures []openapi.ArtifactSignature
+		err        string
+	}{
+		{
+			name:       "signed by a trusted key",
+			digests:    digests,
+			signatures: []openapi.ArtifactSignature{signature},
+		},
+		{
+			name:    "not signed",
+			digests: digests,
+			err:     "not signed by any trusted key",
+		},
+		{
+			name:       "digests changed after signing",
+			digests:    []openapi.ArtifactDigest{{Algorithm: "sha256", Value: sha256Hex("tampered")}},
+			signatures: []openapi.ArtifactSignature{signature},
+			err:        "not signed by any trusted key",
+		},
+		{
+			name:       "no digests",
+			signatures: []openapi.ArtifactSignature{signature},
+			err:        "can't be verified",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signatures = tc.signatures
+			err := provider.verifySignatures(provider.Client, &openapi.ModelArtifact{Id: &id, Digests: tc.digests})
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// tlsConfig returns the TLS configuration verifying the model registry certificate with the CA bundle, when set, and
+// presenting the client certificate, when set
+func tlsConfig(cfg ClientConfig) (*tls.Config, error) {
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, fmt.Errorf("client certificate and key files must be provided together")
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA bundle %s: %w", cfg.CAFile, err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificate found in CA bundle %s", cfg.CAFile)
+		}
+	}
+	if cfg.CertFile != "" {
+		cert := &clientCertificate{certFile: cfg.CertFile, keyFile: cfg.KeyFile}
+		if _, err := cert.get(nil); err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = cert.get
+	}
+	return config, nil
+}
+
+// clientCertificate is a key pair read from files, reloaded whenever the modification time of either file changes,
+// e.g. on Kubernetes secret rotation. When rotated files cannot be loaded the previous key pair keeps being used.
+type clientCertificate struct {
+	certFile string
+	keyFile  string
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	modTimes [2]time.Time
+}
+
+func (c *clientCertificate) get(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	modTimes, err := c.modTimesOf()
+	if err == nil && c.cert != nil && modTimes == c.modTimes {
+		return c.cert, nil
+	}
+	if err == nil {
+		var pair tls.Certificate
+		pair, err = tls.LoadX509KeyPair(c.certFile, c.keyFile)
+		if err == nil {
+			c.cert, c.modTimes = &pair, modTimes
+			return c.cert, nil
+		}
+	}
+	if c.cert == nil {
+		return nil, fmt.Errorf("error loading client certificate %s, %s: %w", c.certFile, c.keyFile, err)
+	}
+	log.Printf("Error reloading client certificate %s, keeping the previous one: %v", c.certFile, err)
+	return c.cert, nil
+}
+
+func (c *clientCertificate) modTimesOf() ([2]time.Time, error) {
+	var modTimes [2]time.Time
+	for i, file := range []string{c.certFile, c.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return modTimes, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// Options of model-registry:// URIs, in their query
+const (
+	versionIdOption = "versionId"
+	aliasOption     = "alias"
+	stateOption     = "state"
+	artifactOption  = "artifact"
+	formatOption    = "format"
+	registryOption  = "registry"
+)
+
+// aliasProperty is the string custom property of model versions holding their alias, e.g. production
+const aliasProperty = "alias"
+
+const uriUsage = "use like model-registry://{registeredModelName}[/{versionName}][?versionId=&alias=&state=&artifact=&format=&registry=]"
+
+// modelRegistryUri is a parsed model-registry:// storage URI:
+//
+//	model-registry://{registeredModelName}[/{versionName}][?{options}]
+//
+// The version is selected by its name, its id (versionId option) or its alias (alias option), and is otherwise the
+// latest one. Only versions in state, LIVE by default, are selected. The artifact is the latest LIVE model artifact
+// of the version, or the latest one named artifact, preferring the ones of model format format when set. The registry
+// option, host[:port] optionally prefixed by a scheme, pulls from another registry than the configured one.
+type modelRegistryUri struct {
+	RegisteredModelName string
+	VersionName         string
+	VersionId           string
+	Alias               string
+	State               openapi.ModelVersionState
+	ArtifactName        string
+	ModelFormatName     string
+	Registry            string
+}
+
+func parseModelRegistryUri(storageUri string) (*modelRegistryUri, error) {
+	if !strings.HasPrefix(storageUri, string(MR)) {
+		return nil, fmt.Errorf("invalid model registry URI %s, %s", storageUri, uriUsage)
+	}
+	path, rawQuery, _ := strings.Cut(strings.TrimPrefix(storageUri, string(MR)), "?")
+	modelName, versionName, _ := strings.Cut(path, "/")
+	uri := &modelRegistryUri{State: openapi.MODELVERSIONSTATE_LIVE}
+	var err error
+	if uri.RegisteredModelName, err = url.PathUnescape(modelName); err != nil || uri.RegisteredModelName == "" {
+		return nil, fmt.Errorf("invalid registered model name in model registry URI %s, %s", storageUri, uriUsage)
+	}
+	if uri.VersionName, err = url.PathUnescape(versionName); err != nil {
+		return nil, fmt.Errorf("invalid version name in model registry URI %s, %s", storageUri, uriUsage)
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options in model registry URI %s: %w", storageUri, err)
+	}
+	for option, values := range query {
+		if len(values) != 1 {
+			return nil, fmt.Errorf("option %s is set more than once in model registry URI %s", option, storageUri)
+		}
+		value := values[0]
+		switch option {
+		case versionIdOption:
+			uri.VersionId = value
+		case aliasOption:
+			uri.Alias = value
+		case stateOption:
+			state, err := openapi.NewModelVersionStateFromValue(strings.ToUpper(value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid state %s in model registry URI %s, expected one of %v", value, storageUri, openapi.AllowedModelVersionStateEnumValues)
+			}
+			uri.State = *state
+		case artifactOption:
+			uri.ArtifactName = value
+		case formatOption:
+			uri.ModelFormatName = value
+		case registryOption:
+			uri.Registry = value
+		default:
+			return nil, fmt.Errorf("unknown option %s in model registry URI %s, %s", option, storageUri, uriUsage)
+		}
+	}
+
+	selectors := 0
+	for _, selector := range []string{uri.VersionName, uri.VersionId, uri.Alias} {
+		if selector != "" {
+			selectors++
+		}
+	}
+	if selectors > 1 {
+		return nil, fmt.Errorf("model registry URI %s selects the version with more than one of its name, %s and %s", storageUri, versionIdOption, aliasOption)
+	}
+	return uri, nil
+}
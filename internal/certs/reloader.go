@@ -0,0 +1,161 @@
+// Package certs loads TLS certificates from files, reloading them when rotated on disk
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader serves a certificate key pair and an optional CA bundle read from files, the files are checked
+// on every handshake and reloaded whenever their modification time changes, e.g. on Kubernetes secret rotation.
+// When a rotated file cannot be loaded the previous material keeps being served.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string // optional, CA bundle used to verify peer certificates
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+// NewReloader creates a Reloader for the provided files, failing if they cannot be loaded initially.
+// certFile and keyFile may be empty when only caFile is needed, and vice versa.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("certificate and key files must be provided together")
+	}
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		modTimes: map[string]time.Time{},
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, suitable for tls.Config.GetCertificate
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.certificate()
+}
+
+// GetClientCertificate returns the current certificate, suitable for tls.Config.GetClientCertificate
+func (r *Reloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.certificate()
+}
+
+// CAPool returns the current CA bundle, nil when no CA file was provided
+func (r *Reloader) CAPool() *x509.CertPool {
+	r.maybeReload()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.caPool
+}
+
+// ServerConfig returns a server tls.Config serving the current certificate, when a CA bundle is provided
+// clients are required to present a certificate signed by it (mTLS).
+func (r *Reloader) ServerConfig() *tls.Config {
+	base := &tls.Config{MinVersion: tls.VersionTLS12}
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		cfg.GetCertificate = r.GetCertificate
+		if pool := r.CAPool(); pool != nil {
+			cfg.ClientCAs = pool
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		return cfg, nil
+	}
+	return base
+}
+
+func (r *Reloader) certificate() (*tls.Certificate, error) {
+	r.maybeReload()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.cert == nil {
+		return nil, fmt.Errorf("no certificate configured")
+	}
+	return r.cert, nil
+}
+
+// maybeReload reloads all files if any of them changed since the last load
+func (r *Reloader) maybeReload() {
+	changed := false
+	r.mu.RLock()
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err == nil && !info.ModTime().Equal(r.modTimes[file]) {
+			changed = true
+			break
+		}
+	}
+	r.mu.RUnlock()
+	if !changed {
+		return
+	}
+	if err := r.load(); err != nil {
+		slog.Warn("error reloading TLS material, keeping the previous one", "error", err)
+		return
+	}
+	slog.Info("reloaded TLS material", "files", r.files())
+}
+
+// load reads all files, replacing the current material only when all of them are valid
+func (r *Reloader) load() error {
+	modTimes := map[string]time.Time{}
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", file, err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("error loading key pair %s, %s: %w", r.certFile, r.keyFile, err)
+		}
+		cert = &pair
+	}
+
+	var caPool *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("error reading CA bundle %s: %w", r.caFile, err)
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no valid certificate found in CA bundle %s", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = cert
+	r.caPool = caPool
+	r.modTimes = modTimes
+	return nil
+}
+
+func (r *Reloader) files() []string {
+	files := []string{}
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files
+}
@@ -0,0 +1,94 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeKeyPair writes a self-signed certificate for the provided common name, returning its DER bytes
+func writeKeyPair(t *testing.T, certFile, keyFile, commonName string, modTime time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error creating certificate: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("error marshaling key: %v", err)
+	}
+	for file, block := range map[string]*pem.Block{
+		certFile: {Type: "CERTIFICATE", Bytes: der},
+		keyFile:  {Type: "EC PRIVATE KEY", Bytes: keyDer},
+	} {
+		if err := os.WriteFile(file, pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatalf("error writing %s: %v", file, err)
+		}
+		if err := os.Chtimes(file, modTime, modTime); err != nil {
+			t.Fatalf("error setting modification time of %s: %v", file, err)
+		}
+	}
+	return der
+}
+
+func TestReloaderReloadsRotatedCertificate(t *testing.T) {
+	assertion := assert.New(t)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	first := writeKeyPair(t, certFile, keyFile, "first", time.Now().Add(-time.Minute))
+	reloader, err := NewReloader(certFile, keyFile, certFile)
+	assertion.Nil(err)
+
+	cert, err := reloader.GetCertificate(nil)
+	assertion.Nil(err)
+	assertion.Equal(first, cert.Certificate[0])
+	assertion.NotNil(reloader.CAPool())
+
+	second := writeKeyPair(t, certFile, keyFile, "second", time.Now())
+	cert, err = reloader.GetCertificate(nil)
+	assertion.Nil(err)
+	assertion.Equal(second, cert.Certificate[0])
+}
+
+func TestReloaderKeepsPreviousCertificateOnInvalidRotation(t *testing.T) {
+	assertion := assert.New(t)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	first := writeKeyPair(t, certFile, keyFile, "first", time.Now().Add(-time.Minute))
+	reloader, err := NewReloader(certFile, keyFile, "")
+	assertion.Nil(err)
+	assertion.Nil(reloader.CAPool())
+
+	assertion.Nil(os.WriteFile(keyFile, []byte("garbage"), 0600))
+	cert, err := reloader.GetCertificate(nil)
+	assertion.Nil(err)
+	assertion.Equal(first, cert.Certificate[0])
+}
+
+func TestNewReloaderRequiresCertAndKeyTogether(t *testing.T) {
+	_, err := NewReloader("tls.crt", "", "")
+	assert.NotNil(t, err)
+}
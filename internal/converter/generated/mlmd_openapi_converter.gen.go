@@ -104,6 +104,16 @@ func (c *MLMDToOpenAPIConverterImpl) ConvertModelArtifact(source *proto.Artifact
 		openapiModelArtifact.StoragePath = converter.MapModelArtifactStoragePath((*source).Properties)
 		openapiModelArtifact.ModelFormatVersion = converter.MapModelArtifactFormatVersion((*source).Properties)
 		openapiModelArtifact.ServiceAccountName = converter.MapModelArtifactServiceAccountName((*source).Properties)
+		pOpenapiModelSignature, err := converter.MapModelArtifactSignature((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Signature: %w", err)
+		}
+		openapiModelArtifact.Signature = pOpenapiModelSignature
+		openapiArtifactDigestList, err := converter.MapModelArtifactDigests((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Digests: %w", err)
+		}
+		openapiModelArtifact.Digests = openapiArtifactDigestList
 		pOpenapiModelArtifact = &openapiModelArtifact
 	}
 	return pOpenapiModelArtifact, nil
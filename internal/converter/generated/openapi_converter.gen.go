@@ -156,6 +156,13 @@ func (c *OpenAPIConverterImpl) ConvertModelArtifactCreate(source *openapi.ModelA
 			xstring9 := *(*source).ServiceAccountName
 			openapiModelArtifact.ServiceAccountName = &xstring9
 		}
+		openapiModelArtifact.Signature = c.pOpenapiModelSignatureToPOpenapiModelSignature((*source).Signature)
+		if (*source).Digests != nil {
+			openapiModelArtifact.Digests = make([]openapi.ArtifactDigest, len((*source).Digests))
+			for i := 0; i < len((*source).Digests); i++ {
+				openapiModelArtifact.Digests[i] = c.openapiArtifactDigestToOpenapiArtifactDigest((*source).Digests[i])
+			}
+		}
 		pOpenapiModelArtifact = &openapiModelArtifact
 	}
 	return pOpenapiModelArtifact, nil
@@ -213,6 +220,13 @@ func (c *OpenAPIConverterImpl) ConvertModelArtifactUpdate(source *openapi.ModelA
 			xstring8 := *(*source).ServiceAccountName
 			openapiModelArtifact.ServiceAccountName = &xstring8
 		}
+		openapiModelArtifact.Signature = c.pOpenapiModelSignatureToPOpenapiModelSignature((*source).Signature)
+		if (*source).Digests != nil {
+			openapiModelArtifact.Digests = make([]openapi.ArtifactDigest, len((*source).Digests))
+			for i := 0; i < len((*source).Digests); i++ {
+				openapiModelArtifact.Digests[i] = c.openapiArtifactDigestToOpenapiArtifactDigest((*source).Digests[i])
+			}
+		}
 		pOpenapiModelArtifact = &openapiModelArtifact
 	}
 	return pOpenapiModelArtifact, nil
@@ -613,6 +627,16 @@ func (c *OpenAPIConverterImpl) OverrideNotEditableForServingEnvironment(source c
 	}
 	return openapiServingEnvironment, nil
 }
+func (c *OpenAPIConverterImpl) openapiArtifactDigestToOpenapiArtifactDigest(source openapi.ArtifactDigest) openapi.ArtifactDigest {
+	var openapiArtifactDigest openapi.ArtifactDigest
+	if source.Path != nil {
+		xstring := *source.Path
+		openapiArtifactDigest.Path = &xstring
+	}
+	openapiArtifactDigest.Algorithm = source.Algorithm
+	openapiArtifactDigest.Value = source.Value
+	return openapiArtifactDigest
+}
 func (c *OpenAPIConverterImpl) openapiArtifactStateToOpenapiArtifactState(source openapi.ArtifactState) (openapi.ArtifactState, error) {
 	var openapiArtifactState openapi.ArtifactState
 	switch source {
@@ -674,6 +698,7 @@ func (c *OpenAPIConverterImpl) openapiMetadataValueToOpenapiMetadataValue(source
 	openapiMetadataValue.MetadataBoolValue = c.pOpenapiMetadataBoolValueToPOpenapiMetadataBoolValue(source.MetadataBoolValue)
 	openapiMetadataValue.MetadataDoubleValue = c.pOpenapiMetadataDoubleValueToPOpenapiMetadataDoubleValue(source.MetadataDoubleValue)
 	openapiMetadataValue.MetadataIntValue = c.pOpenapiMetadataIntValueToPOpenapiMetadataIntValue(source.MetadataIntValue)
+	openapiMetadataValue.MetadataListValue = c.pOpenapiMetadataListValueToPOpenapiMetadataListValue(source.MetadataListValue)
 	openapiMetadataValue.MetadataProtoValue = c.pOpenapiMetadataProtoValueToPOpenapiMetadataProtoValue(source.MetadataProtoValue)
 	openapiMetadataValue.MetadataStringValue = c.pOpenapiMetadataStringValueToPOpenapiMetadataStringValue(source.MetadataStringValue)
 	openapiMetadataValue.MetadataStructValue = c.pOpenapiMetadataStructValueToPOpenapiMetadataStructValue(source.MetadataStructValue)
@@ -703,6 +728,27 @@ func (c *OpenAPIConverterImpl) openapiRegisteredModelStateToOpenapiRegisteredMod
 	}
 	return openapiRegisteredModelState, nil
 }
+func (c *OpenAPIConverterImpl) openapiTensorSpecToOpenapiTensorSpec(source openapi.TensorSpec) openapi.TensorSpec {
+	var openapiTensorSpec openapi.TensorSpec
+	openapiTensorSpec.Name = source.Name
+	openapiTensorSpec.Dtype = source.Dtype
+	if source.Shape != nil {
+		openapiTensorSpec.Shape = make([]int64, len(source.Shape))
+		for i := 0; i < len(source.Shape); i++ {
+			openapiTensorSpec.Shape[i] = source.Shape[i]
+		}
+	}
+	if source.Optional != nil {
+		xbool := *source.Optional
+		openapiTensorSpec.Optional = &xbool
+	}
+	if source.Description != nil {
+		xstring := *source.Description
+		openapiTensorSpec.Description = &xstring
+	}
+	openapiTensorSpec.Example = converter.CopyJSONValue(source.Example)
+	return openapiTensorSpec
+}
 func (c *OpenAPIConverterImpl) pOpenapiMetadataBoolValueToPOpenapiMetadataBoolValue(source *openapi.MetadataBoolValue) *openapi.MetadataBoolValue {
 	var pOpenapiMetadataBoolValue *openapi.MetadataBoolValue
 	if source != nil {
@@ -733,6 +779,21 @@ func (c *OpenAPIConverterImpl) pOpenapiMetadataIntValueToPOpenapiMetadataIntValu
 	}
 	return pOpenapiMetadataIntValue
 }
+func (c *OpenAPIConverterImpl) pOpenapiMetadataListValueToPOpenapiMetadataListValue(source *openapi.MetadataListValue) *openapi.MetadataListValue {
+	var pOpenapiMetadataListValue *openapi.MetadataListValue
+	if source != nil {
+		var openapiMetadataListValue openapi.MetadataListValue
+		if (*source).ListValue != nil {
+			openapiMetadataListValue.ListValue = make([]interface{}, len((*source).ListValue))
+			for i := 0; i < len((*source).ListValue); i++ {
+				openapiMetadataListValue.ListValue[i] = converter.CopyJSONValue((*source).ListValue[i])
+			}
+		}
+		openapiMetadataListValue.MetadataType = (*source).MetadataType
+		pOpenapiMetadataListValue = &openapiMetadataListValue
+	}
+	return pOpenapiMetadataListValue
+}
 func (c *OpenAPIConverterImpl) pOpenapiMetadataProtoValueToPOpenapiMetadataProtoValue(source *openapi.MetadataProtoValue) *openapi.MetadataProtoValue {
 	var pOpenapiMetadataProtoValue *openapi.MetadataProtoValue
 	if source != nil {
@@ -758,9 +819,29 @@ func (c *OpenAPIConverterImpl) pOpenapiMetadataStructValueToPOpenapiMetadataStru
 	var pOpenapiMetadataStructValue *openapi.MetadataStructValue
 	if source != nil {
 		var openapiMetadataStructValue openapi.MetadataStructValue
-		openapiMetadataStructValue.StructValue = (*source).StructValue
+		openapiMetadataStructValue.StructValue = converter.CopyJSONValue((*source).StructValue)
 		openapiMetadataStructValue.MetadataType = (*source).MetadataType
 		pOpenapiMetadataStructValue = &openapiMetadataStructValue
 	}
 	return pOpenapiMetadataStructValue
 }
+func (c *OpenAPIConverterImpl) pOpenapiModelSignatureToPOpenapiModelSignature(source *openapi.ModelSignature) *openapi.ModelSignature {
+	var pOpenapiModelSignature *openapi.ModelSignature
+	if source != nil {
+		var openapiModelSignature openapi.ModelSignature
+		if (*source).Inputs != nil {
+			openapiModelSignature.Inputs = make([]openapi.TensorSpec, len((*source).Inputs))
+			for i := 0; i < len((*source).Inputs); i++ {
+				openapiModelSignature.Inputs[i] = c.openapiTensorSpecToOpenapiTensorSpec((*source).Inputs[i])
+			}
+		}
+		if (*source).Outputs != nil {
+			openapiModelSignature.Outputs = make([]openapi.TensorSpec, len((*source).Outputs))
+			for j := 0; j < len((*source).Outputs); j++ {
+				openapiModelSignature.Outputs[j] = c.openapiTensorSpecToOpenapiTensorSpec((*source).Outputs[j])
+			}
+		}
+		pOpenapiModelSignature = &openapiModelSignature
+	}
+	return pOpenapiModelSignature
+}
@@ -216,6 +216,8 @@ func (c *OpenAPIReconcilerImpl) UpdateExistingModelArtifact(source converter.Ope
 		xstring8 := *pString8
 		openapiModelArtifact.ServiceAccountName = &xstring8
 	}
+	openapiModelArtifact.Signature = converter.UpdateModelArtifactSignature(source)
+	openapiModelArtifact.Digests = converter.UpdateModelArtifactDigests(source)
 	return openapiModelArtifact, nil
 }
 func (c *OpenAPIReconcilerImpl) UpdateExistingModelVersion(source converter.OpenapiUpdateWrapper[openapi.ModelVersion]) (openapi.ModelVersion, error) {
@@ -464,6 +466,7 @@ func (c *OpenAPIReconcilerImpl) openapiMetadataValueToOpenapiMetadataValue(sourc
 	openapiMetadataValue.MetadataBoolValue = c.pOpenapiMetadataBoolValueToPOpenapiMetadataBoolValue(source.MetadataBoolValue)
 	openapiMetadataValue.MetadataDoubleValue = c.pOpenapiMetadataDoubleValueToPOpenapiMetadataDoubleValue(source.MetadataDoubleValue)
 	openapiMetadataValue.MetadataIntValue = c.pOpenapiMetadataIntValueToPOpenapiMetadataIntValue(source.MetadataIntValue)
+	openapiMetadataValue.MetadataListValue = c.pOpenapiMetadataListValueToPOpenapiMetadataListValue(source.MetadataListValue)
 	openapiMetadataValue.MetadataProtoValue = c.pOpenapiMetadataProtoValueToPOpenapiMetadataProtoValue(source.MetadataProtoValue)
 	openapiMetadataValue.MetadataStringValue = c.pOpenapiMetadataStringValueToPOpenapiMetadataStringValue(source.MetadataStringValue)
 	openapiMetadataValue.MetadataStructValue = c.pOpenapiMetadataStructValueToPOpenapiMetadataStructValue(source.MetadataStructValue)
@@ -523,6 +526,21 @@ func (c *OpenAPIReconcilerImpl) pOpenapiMetadataIntValueToPOpenapiMetadataIntVal
 	}
 	return pOpenapiMetadataIntValue
 }
+func (c *OpenAPIReconcilerImpl) pOpenapiMetadataListValueToPOpenapiMetadataListValue(source *openapi.MetadataListValue) *openapi.MetadataListValue {
+	var pOpenapiMetadataListValue *openapi.MetadataListValue
+	if source != nil {
+		var openapiMetadataListValue openapi.MetadataListValue
+		if (*source).ListValue != nil {
+			openapiMetadataListValue.ListValue = make([]interface{}, len((*source).ListValue))
+			for i := 0; i < len((*source).ListValue); i++ {
+				openapiMetadataListValue.ListValue[i] = converter.CopyJSONValue((*source).ListValue[i])
+			}
+		}
+		openapiMetadataListValue.MetadataType = (*source).MetadataType
+		pOpenapiMetadataListValue = &openapiMetadataListValue
+	}
+	return pOpenapiMetadataListValue
+}
 func (c *OpenAPIReconcilerImpl) pOpenapiMetadataProtoValueToPOpenapiMetadataProtoValue(source *openapi.MetadataProtoValue) *openapi.MetadataProtoValue {
 	var pOpenapiMetadataProtoValue *openapi.MetadataProtoValue
 	if source != nil {
@@ -548,7 +566,7 @@ func (c *OpenAPIReconcilerImpl) pOpenapiMetadataStructValueToPOpenapiMetadataStr
 	var pOpenapiMetadataStructValue *openapi.MetadataStructValue
 	if source != nil {
 		var openapiMetadataStructValue openapi.MetadataStructValue
-		openapiMetadataStructValue.StructValue = (*source).StructValue
+		openapiMetadataStructValue.StructValue = converter.CopyJSONValue((*source).StructValue)
 		openapiMetadataStructValue.MetadataType = (*source).MetadataType
 		pOpenapiMetadataStructValue = &openapiMetadataStructValue
 	}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kubeflow/model-registry/internal/defaults"
 	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
 	"github.com/kubeflow/model-registry/pkg/openapi"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func setup(t *testing.T) *assert.Assertions {
@@ -128,20 +131,57 @@ func TestMetadataValueStruct(t *testing.T) {
 	roundTripAndAssert(t, data, key)
 }
 
-func TestMetadataValueProtoUnsupported(t *testing.T) {
+func TestMetadataValueStructAsObject(t *testing.T) {
+	assertion := setup(t)
+	data := make(map[string]openapi.MetadataValue)
+	key := "my struct"
+
+	myMap := map[string]interface{}{"name": "John Doe", "age": 47.0}
+	data[key] = openapi.MetadataStructValueAsMetadataValue(&openapi.MetadataStructValue{StructValue: myMap, MetadataType: "MetadataStructValue"})
+
+	asGRPC, err := MapOpenAPICustomProperties(&data)
+	assertion.Nil(err)
+	assertion.Equal(myMap, asGRPC[key].GetStructValue().AsMap())
+
+	// struct values are returned base64 encoded, as before
+	unmarshall, err := MapMLMDCustomProperties(asGRPC)
+	assertion.Nil(err)
+	b64, ok := unmarshall[key].MetadataStructValue.StructValue.(string)
+	assertion.True(ok)
+	asJSON, err := base64.StdEncoding.DecodeString(b64)
+	assertion.Nil(err)
+	assertion.JSONEq(`{"name":"John Doe","age":47}`, string(asJSON))
+
+	data[key] = openapi.MetadataStructValueAsMetadataValue(&openapi.MetadataStructValue{StructValue: 47, MetadataType: "MetadataStructValue"})
+	_, err = MapOpenAPICustomProperties(&data)
+	assertion.NotNil(err)
+}
+
+func TestMetadataValueProto(t *testing.T) {
 	data := make(map[string]openapi.MetadataValue)
 	key := "my proto"
 
-	myMap := make(map[string]interface{})
-	myMap["name"] = "John Doe"
-	myMap["age"] = 47
-	asJSON, err := json.Marshal(myMap)
+	asAny, err := anypb.New(durationpb.New(90 * time.Second))
 	if err != nil {
 		t.Error(err)
 	}
-	b64 := base64.StdEncoding.EncodeToString(asJSON)
-	typeDef := "map[string]openapi.MetadataValue"
-	data[key] = openapi.MetadataProtoValueAsMetadataValue(NewMetadataProtoValue(typeDef, b64))
+	b64 := base64.StdEncoding.EncodeToString(asAny.Value)
+	data[key] = openapi.MetadataProtoValueAsMetadataValue(NewMetadataProtoValue(asAny.TypeUrl, b64))
+
+	roundTripAndAssert(t, data, key)
+
+	assertion := setup(t)
+	asGRPC, err := MapOpenAPICustomProperties(&data)
+	assertion.Nil(err)
+	duration := &durationpb.Duration{}
+	assertion.Nil(asGRPC[key].GetProtoValue().UnmarshalTo(duration))
+	assertion.Equal(90*time.Second, duration.AsDuration())
+}
+
+func TestMetadataValueProtoInvalid(t *testing.T) {
+	data := make(map[string]openapi.MetadataValue)
+	key := "my proto"
+	data[key] = openapi.MetadataProtoValueAsMetadataValue(NewMetadataProtoValue("type.googleapis.com/google.protobuf.Duration", "not base64!"))
 
 	assertion := setup(t)
 	asGRPC, err := MapOpenAPICustomProperties(&data)
@@ -150,6 +190,19 @@ func TestMetadataValueProtoUnsupported(t *testing.T) {
 	}
 }
 
+func TestMetadataValueList(t *testing.T) {
+	data := make(map[string]openapi.MetadataValue)
+	key := "tags"
+	data[key] = openapi.MetadataListValueAsMetadataValue(NewMetadataListValue([]interface{}{"nlp", "llm", 2.0, true, map[string]interface{}{"team": "a"}}))
+
+	roundTripAndAssert(t, data, key)
+
+	assertion := setup(t)
+	asGRPC, err := MapOpenAPICustomProperties(&data)
+	assertion.Nil(err)
+	assertion.Equal("type.googleapis.com/google.protobuf.ListValue", asGRPC[key].GetProtoValue().GetTypeUrl())
+}
+
 func roundTripAndAssert(t *testing.T, data map[string]openapi.MetadataValue, key string) {
 	assertion := setup(t)
 
@@ -262,15 +315,21 @@ func TestMapModelArtifactProperties(t *testing.T) {
 		StorageKey:         of("storage-key"),
 		StoragePath:        of("storage-path"),
 		ServiceAccountName: of("service-account-name"),
+		Signature: &openapi.ModelSignature{
+			Inputs: []openapi.TensorSpec{{Name: "x", Dtype: "float32", Shape: []int64{-1, 4}}},
+		},
+		Digests: []openapi.ArtifactDigest{{Algorithm: "sha256", Value: "ab"}},
 	})
 	assertion.Nil(err)
-	assertion.Equal(6, len(props))
+	assertion.Equal(8, len(props))
 	assertion.Equal("my model art description", props["description"].GetStringValue())
 	assertion.Equal("sklearn", props["model_format_name"].GetStringValue())
 	assertion.Equal("1.0", props["model_format_version"].GetStringValue())
 	assertion.Equal("storage-key", props["storage_key"].GetStringValue())
 	assertion.Equal("storage-path", props["storage_path"].GetStringValue())
 	assertion.Equal("service-account-name", props["service_account_name"].GetStringValue())
+	assertion.JSONEq(`{"inputs":[{"name":"x","dtype":"float32","shape":[-1,4]}]}`, props["signature"].GetStringValue())
+	assertion.JSONEq(`[{"algorithm":"sha256","value":"ab"}]`, props["digests"].GetStringValue())
 
 	props, err = MapModelArtifactProperties(&openapi.ModelArtifact{
 		Name: of("v1"),
@@ -512,6 +571,50 @@ func TestMapModelArtifactServiceAccountName(t *testing.T) {
 	assertion.Equal("my-account", *extracted)
 }
 
+func TestMapModelArtifactSignature(t *testing.T) {
+	assertion := setup(t)
+
+	extracted, err := MapModelArtifactSignature(map[string]*proto.Value{
+		"signature": {
+			Value: &proto.Value_StringValue{
+				StringValue: `{"inputs":[{"name":"x","dtype":"float32","shape":[-1,4],"example":[[1,2,3,4]]}],"outputs":[{"name":"y","dtype":"int64"}]}`,
+			},
+		},
+	})
+	assertion.Nil(err)
+	assertion.Equal(openapi.ModelSignature{
+		Inputs:  []openapi.TensorSpec{{Name: "x", Dtype: "float32", Shape: []int64{-1, 4}, Example: []interface{}{[]interface{}{1.0, 2.0, 3.0, 4.0}}}},
+		Outputs: []openapi.TensorSpec{{Name: "y", Dtype: "int64"}},
+	}, *extracted)
+
+	extracted, err = MapModelArtifactSignature(map[string]*proto.Value{})
+	assertion.Nil(err)
+	assertion.Nil(extracted)
+
+	_, err = MapModelArtifactSignature(map[string]*proto.Value{
+		"signature": {Value: &proto.Value_StringValue{StringValue: "{"}},
+	})
+	assertion.NotNil(err)
+}
+
+func TestMapModelArtifactDigests(t *testing.T) {
+	assertion := setup(t)
+
+	extracted, err := MapModelArtifactDigests(map[string]*proto.Value{
+		"digests": {
+			Value: &proto.Value_StringValue{
+				StringValue: `[{"path":"model.onnx","algorithm":"sha256","value":"ab"}]`,
+			},
+		},
+	})
+	assertion.Nil(err)
+	assertion.Equal([]openapi.ArtifactDigest{{Path: of("model.onnx"), Algorithm: "sha256", Value: "ab"}}, extracted)
+
+	extracted, err = MapModelArtifactDigests(map[string]*proto.Value{})
+	assertion.Nil(err)
+	assertion.Nil(extracted)
+}
+
 func TestMapPropertyModelVersionId(t *testing.T) {
 	assertion := setup(t)
 
@@ -35,6 +35,8 @@ type MLMDToOpenAPIConverter interface {
 	// goverter:map Properties StorageKey | MapModelArtifactStorageKey
 	// goverter:map Properties StoragePath | MapModelArtifactStoragePath
 	// goverter:map Properties ServiceAccountName | MapModelArtifactServiceAccountName
+	// goverter:map Properties Signature | MapModelArtifactSignature
+	// goverter:map Properties Digests | MapModelArtifactDigests
 	ConvertModelArtifact(source *proto.Artifact) (*openapi.ModelArtifact, error)
 
 	// goverter:map Name | MapNameFromOwned
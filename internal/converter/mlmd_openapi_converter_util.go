@@ -10,6 +10,7 @@ import (
 	"github.com/kubeflow/model-registry/internal/defaults"
 	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
 	"github.com/kubeflow/model-registry/pkg/openapi"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func NewMetadataStringValue(value string) *openapi.MetadataStringValue {
@@ -42,6 +43,12 @@ func NewMetadataStructValue(value string) *openapi.MetadataStructValue {
 	return result
 }
 
+func NewMetadataListValue(value []interface{}) *openapi.MetadataListValue {
+	result := openapi.NewMetadataListValueWithDefaults()
+	result.ListValue = value
+	return result
+}
+
 func NewMetadataProtoValue(typeDef string, value string) *openapi.MetadataProtoValue {
 	result := openapi.NewMetadataProtoValueWithDefaults()
 	result.Type = typeDef
@@ -75,6 +82,17 @@ func MapMLMDCustomProperties(source map[string]*proto.Value) (map[string]openapi
 			}
 			b64 := base64.StdEncoding.EncodeToString(asJSON)
 			customValue.MetadataStructValue = NewMetadataStructValue(b64)
+		case *proto.Value_ProtoValue:
+			pv := typedValue.ProtoValue
+			if pv.MessageIs(&structpb.ListValue{}) {
+				asList := &structpb.ListValue{}
+				if err := pv.UnmarshalTo(asList); err != nil {
+					return nil, fmt.Errorf("unable to decode %w for key %s", err, key)
+				}
+				customValue.MetadataListValue = NewMetadataListValue(asList.AsSlice())
+			} else {
+				customValue.MetadataProtoValue = NewMetadataProtoValue(pv.GetTypeUrl(), base64.StdEncoding.EncodeToString(pv.GetValue()))
+			}
 		default:
 			return nil, fmt.Errorf("type mapping not found for %s:%v", key, v)
 		}
@@ -235,6 +253,32 @@ func MapModelArtifactServiceAccountName(properties map[string]*proto.Value) *str
 	return MapStringProperty(properties, "service_account_name")
 }
 
+// MapModelArtifactSignature decodes the JSON signature property of a model artifact
+func MapModelArtifactSignature(properties map[string]*proto.Value) (*openapi.ModelSignature, error) {
+	signature := MapStringProperty(properties, "signature")
+	if signature == nil {
+		return nil, nil
+	}
+	result := openapi.ModelSignature{}
+	if err := json.Unmarshal([]byte(*signature), &result); err != nil {
+		return nil, fmt.Errorf("error decoding model artifact signature: %w", err)
+	}
+	return &result, nil
+}
+
+// MapModelArtifactDigests decodes the JSON digests property of a model artifact
+func MapModelArtifactDigests(properties map[string]*proto.Value) ([]openapi.ArtifactDigest, error) {
+	digests := MapStringProperty(properties, "digests")
+	if digests == nil {
+		return nil, nil
+	}
+	result := []openapi.ArtifactDigest{}
+	if err := json.Unmarshal([]byte(*digests), &result); err != nil {
+		return nil, fmt.Errorf("error decoding model artifact digests: %w", err)
+	}
+	return result, nil
+}
+
 func MapPropertyModelVersionId(properties map[string]*proto.Value) *string {
 	return MapIntProperty(properties, "model_version_id")
 }
@@ -39,3 +39,26 @@ func InitWithUpdate[M OpenAPIModel](source OpenapiUpdateWrapper[M]) M {
 	var m M
 	return m
 }
+
+// CopyJSONValue returns the JSON value of struct and list custom properties as is, since they are never modified in place
+func CopyJSONValue(source interface{}) interface{} {
+	return source
+}
+
+// UpdateModelArtifactSignature returns the signature of the updated model artifact, the existing one when the update
+// doesn't set any
+func UpdateModelArtifactSignature(source OpenapiUpdateWrapper[openapi.ModelArtifact]) *openapi.ModelSignature {
+	if source.Update != nil && source.Update.Signature != nil {
+		return source.Update.Signature
+	}
+	return source.Existing.Signature
+}
+
+// UpdateModelArtifactDigests returns the digests of the updated model artifact, the existing ones when the update
+// doesn't set any
+func UpdateModelArtifactDigests(source OpenapiUpdateWrapper[openapi.ModelArtifact]) []openapi.ArtifactDigest {
+	if source.Update != nil && source.Update.Digests != nil {
+		return source.Update.Digests
+	}
+	return source.Existing.Digests
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kubeflow/model-registry/internal/defaults"
 	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
 	"github.com/kubeflow/model-registry/pkg/openapi"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -76,21 +77,34 @@ func MapOpenAPICustomProperties(source *map[string]openapi.MetadataValue) (map[s
 				value.Value = &proto.Value_StringValue{StringValue: v.MetadataStringValue.StringValue}
 			// struct value
 			case v.MetadataStructValue != nil:
-				data, err := base64.StdEncoding.DecodeString(v.MetadataStructValue.StructValue)
+				asStruct, err := mapStructValue(v.MetadataStructValue.StructValue)
 				if err != nil {
 					return nil, fmt.Errorf("unable to decode %w for key %s", err, key)
 				}
-				var asMap map[string]interface{}
-				err = json.Unmarshal(data, &asMap)
+				value.Value = &proto.Value_StructValue{
+					StructValue: asStruct,
+				}
+			// proto value
+			case v.MetadataProtoValue != nil:
+				data, err := base64.StdEncoding.DecodeString(v.MetadataProtoValue.ProtoValue)
 				if err != nil {
 					return nil, fmt.Errorf("unable to decode %w for key %s", err, key)
 				}
-				asStruct, err := structpb.NewStruct(asMap)
+				value.Value = &proto.Value_ProtoValue{
+					ProtoValue: &anypb.Any{TypeUrl: v.MetadataProtoValue.Type, Value: data},
+				}
+			// list value, stored as a google.protobuf.ListValue proto value
+			case v.MetadataListValue != nil:
+				asList, err := structpb.NewList(v.MetadataListValue.ListValue)
 				if err != nil {
 					return nil, fmt.Errorf("unable to decode %w for key %s", err, key)
 				}
-				value.Value = &proto.Value_StructValue{
-					StructValue: asStruct,
+				asAny, err := anypb.New(asList)
+				if err != nil {
+					return nil, fmt.Errorf("unable to encode %w for key %s", err, key)
+				}
+				value.Value = &proto.Value_ProtoValue{
+					ProtoValue: asAny,
 				}
 			default:
 				return nil, fmt.Errorf("type mapping not found for %s:%v", key, v)
@@ -103,6 +117,27 @@ func MapOpenAPICustomProperties(source *map[string]openapi.MetadataValue) (map[s
 	return props, nil
 }
 
+// mapStructValue maps an OpenAPI struct value, either a JSON object or the base64 encoded bytes of its JSON
+// representation, to a proto Struct
+func mapStructValue(source interface{}) (*structpb.Struct, error) {
+	switch typedValue := source.(type) {
+	case map[string]interface{}:
+		return structpb.NewStruct(typedValue)
+	case string:
+		data, err := base64.StdEncoding.DecodeString(typedValue)
+		if err != nil {
+			return nil, err
+		}
+		var asMap map[string]interface{}
+		err = json.Unmarshal(data, &asMap)
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewStruct(asMap)
+	}
+	return nil, fmt.Errorf("invalid struct value %v, expected a JSON object or a base64 encoded string", source)
+}
+
 // PrefixWhenOwned compose the mlmd fullname by using ownerId as prefix
 // For owned entity such as ModelVersion
 // for potentially owned entity such as ModelArtifact
@@ -309,6 +344,28 @@ func MapModelArtifactProperties(source *openapi.ModelArtifact) (map[string]*prot
 				},
 			}
 		}
+		if source.Signature != nil {
+			signature, err := json.Marshal(source.Signature)
+			if err != nil {
+				return nil, fmt.Errorf("error encoding model artifact signature: %w", err)
+			}
+			props["signature"] = &proto.Value{
+				Value: &proto.Value_StringValue{
+					StringValue: string(signature),
+				},
+			}
+		}
+		if source.Digests != nil {
+			digests, err := json.Marshal(source.Digests)
+			if err != nil {
+				return nil, fmt.Errorf("error encoding model artifact digests: %w", err)
+			}
+			props["digests"] = &proto.Value{
+				Value: &proto.Value_StringValue{
+					StringValue: string(digests),
+				},
+			}
+		}
 	}
 	return props, nil
 }
@@ -11,6 +11,7 @@ import "github.com/kubeflow/model-registry/pkg/openapi"
 // goverter:enum:unknown @error
 // goverter:matchIgnoreCase
 // goverter:useZeroValueOnPointerInconsistency
+// goverter:extend CopyJSONValue
 type OpenAPIReconciler interface {
 	// Ignore all fields that can't be updated
 	// goverter:default InitWithExisting
@@ -34,6 +35,8 @@ type OpenAPIReconciler interface {
 	// goverter:default InitWithExisting
 	// goverter:autoMap Update
 	// goverter:ignore Id CreateTimeSinceEpoch LastUpdateTimeSinceEpoch Name ArtifactType
+	// goverter:map . Signature | UpdateModelArtifactSignature
+	// goverter:map . Digests | UpdateModelArtifactDigests
 	UpdateExistingModelArtifact(source OpenapiUpdateWrapper[openapi.ModelArtifact]) (openapi.ModelArtifact, error)
 
 	// Ignore all fields that can't be updated
@@ -9,4 +9,5 @@ const (
 	ServingEnvironmentTypeName = "kf.ServingEnvironment"
 	InferenceServiceTypeName   = "kf.InferenceService"
 	ServeModelTypeName         = "kf.ServeModel"
+	SchemaVersionTypeName      = "kf.SchemaVersion"
 )
@@ -0,0 +1,149 @@
+// Package health provides the liveness and readiness endpoints of the Model Registry proxy server
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/kubeflow/model-registry/internal/mlmdtypes"
+	"github.com/kubeflow/model-registry/pkg/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+
+	// defaultCheckTimeout bounds the duration of each check, so that probes answer before the kubelet gives up
+	defaultCheckTimeout = time.Second
+)
+
+// Check verifies a single dependency, returning a descriptive error when it is not healthy
+type Check func(ctx context.Context) error
+
+// CheckResult holds the outcome of a single check
+type CheckResult struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// Report is the JSON body returned by the health endpoints
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Checker runs a set of named checks and reports their results
+type Checker struct {
+	checks  map[string]Check
+	timeout time.Duration
+}
+
+// NewChecker creates a Checker running the provided named checks, each bounded by a default timeout
+func NewChecker(checks map[string]Check) *Checker {
+	return &Checker{
+		checks:  checks,
+		timeout: defaultCheckTimeout,
+	}
+}
+
+// Run executes all checks concurrently, the report status is ok only when every check succeeded
+func (c *Checker) Run(ctx context.Context) Report {
+	type namedResult struct {
+		name   string
+		result CheckResult
+	}
+	results := make(chan namedResult, len(c.checks))
+	for name, check := range c.checks {
+		go func(name string, check Check) {
+			results <- namedResult{name, c.run(ctx, check)}
+		}(name, check)
+	}
+
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(c.checks))}
+	for range c.checks {
+		r := <-results
+		report.Checks[r.name] = r.result
+		if r.result.Status != StatusOK {
+			report.Status = StatusFail
+		}
+	}
+	return report
+}
+
+// run executes a single check, giving up when the timeout expires even if the check does not honor ctx
+func (c *Checker) run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("check timed out after %s", c.timeout)
+	}
+
+	result := CheckResult{Status: StatusOK, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// ServeHTTP writes the JSON report, answering 503 Service Unavailable if any check failed
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := c.Run(r.Context())
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+		slog.WarnContext(r.Context(), "health check failed", "path", r.URL.Path, "checks", report.Checks)
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		slog.ErrorContext(r.Context(), "error encoding health report", "error", err)
+	}
+}
+
+// MLMDConnectionCheck verifies the gRPC connection to MLMD is ready, triggering a reconnection when idle
+func MLMDConnectionCheck(conn *grpc.ClientConn) Check {
+	return func(ctx context.Context) error {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if state == connectivity.Idle {
+			conn.Connect()
+		}
+		return fmt.Errorf("MLMD connection is %s", state)
+	}
+}
+
+// MLMDTypesCheck verifies all Model Registry types still resolve in MLMD, with the same ids found at startup
+func MLMDTypesCheck(conn grpc.ClientConnInterface, nameConfig mlmdtypes.MLMDTypeNamesConfig, expected map[string]int64) Check {
+	return func(ctx context.Context) error {
+		typesMap, err := core.BuildTypesMap(conn, nameConfig)
+		if err != nil {
+			return err
+		}
+		for name, id := range expected {
+			if typesMap[name] != id {
+				return fmt.Errorf("MLMD type %s resolved to id %d, expected %d", name, typesMap[name], id)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,64 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func serve(t *testing.T, checker *Checker) (int, Report) {
+	rec := httptest.NewRecorder()
+	checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var report Report
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatalf("error decoding health report: %v", err)
+	}
+	return rec.Code, report
+}
+
+func TestCheckerWithoutChecksIsHealthy(t *testing.T) {
+	code, report := serve(t, NewChecker(map[string]Check{}))
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOK, report.Status)
+	assert.Empty(t, report.Checks)
+}
+
+func TestCheckerReportsFailingChecks(t *testing.T) {
+	assertion := assert.New(t)
+
+	code, report := serve(t, NewChecker(map[string]Check{
+		"good": func(ctx context.Context) error { return nil },
+		"bad":  func(ctx context.Context) error { return errors.New("connection refused") },
+	}))
+
+	assertion.Equal(http.StatusServiceUnavailable, code)
+	assertion.Equal(StatusFail, report.Status)
+	assertion.Equal(StatusOK, report.Checks["good"].Status)
+	assertion.Equal(StatusFail, report.Checks["bad"].Status)
+	assertion.Equal("connection refused", report.Checks["bad"].Error)
+}
+
+func TestCheckerTimesOutSlowChecks(t *testing.T) {
+	assertion := assert.New(t)
+
+	checker := NewChecker(map[string]Check{
+		"slow": func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		},
+	})
+	checker.timeout = 10 * time.Millisecond
+
+	code, report := serve(t, checker)
+
+	assertion.Equal(http.StatusServiceUnavailable, code)
+	assertion.Contains(report.Checks["slow"].Error, "timed out")
+}
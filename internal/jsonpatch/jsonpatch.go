@@ -0,0 +1,304 @@
+// Package jsonpatch applies JSON Merge Patch (RFC 7396) and JSON Patch (RFC 6902) documents to JSON values as
+// decoded by encoding/json, i.e. made of map[string]any, []any, string, float64, bool and nil.
+package jsonpatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPatch is returned for malformed patches and operations that can't be applied
+	ErrInvalidPatch = errors.New("invalid patch")
+	// ErrTestFailed is returned when the value of a test operation does not match
+	ErrTestFailed = errors.New("test operation failed")
+)
+
+// Operation is a JSON Patch operation
+type Operation struct {
+	Op   string `json:"op"`
+	Path string `json:"path"`
+	From string `json:"from,omitempty"`
+	// Value is nil when missing, as opposed to null
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// MergePatch returns doc patched by the JSON Merge Patch patch: members of patch objects replace the ones of doc,
+// recursively, null members remove them, and any other patch value replaces doc. doc is left unchanged.
+func MergePatch(doc any, patch any) any {
+	members, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	target := map[string]any{}
+	if object, ok := doc.(map[string]any); ok {
+		for key, value := range object {
+			target[key] = value
+		}
+	}
+	for key, value := range members {
+		if value == nil {
+			delete(target, key)
+		} else {
+			target[key] = MergePatch(target[key], value)
+		}
+	}
+	return target
+}
+
+// Apply returns doc patched by the JSON Patch operations, applied in order. doc is left unchanged, and no partial
+// result is returned when an operation fails.
+func Apply(doc any, operations []Operation) (any, error) {
+	doc = deepCopy(doc)
+	for i, op := range operations {
+		var err error
+		if doc, err = apply(doc, op); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+func apply(doc any, op Operation) (any, error) {
+	path, err := ParsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	value := func() (any, error) {
+		if op.Value == nil {
+			return nil, fmt.Errorf("missing value: %w", ErrInvalidPatch)
+		}
+		var value any
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return nil, fmt.Errorf("invalid value: %v: %w", err, ErrInvalidPatch)
+		}
+		return value, nil
+	}
+	from := func() ([]string, error) {
+		if op.From == "" {
+			return nil, fmt.Errorf("missing from: %w", ErrInvalidPatch)
+		}
+		return ParsePointer(op.From)
+	}
+
+	switch op.Op {
+	case "add":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case "remove":
+		doc, _, err := remove(doc, path)
+		return doc, err
+	case "replace":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		if len(path) == 0 {
+			return v, nil
+		}
+		if doc, _, err = remove(doc, path); err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case "move":
+		source, err := from()
+		if err != nil {
+			return nil, err
+		}
+		if len(source) < len(path) && reflect.DeepEqual(source, path[:len(source)]) {
+			return nil, fmt.Errorf("can't move %s into one of its children: %w", op.From, ErrInvalidPatch)
+		}
+		doc, v, err := remove(doc, source)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case "copy":
+		source, err := from()
+		if err != nil {
+			return nil, err
+		}
+		v, err := get(doc, source)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, deepCopy(v))
+	case "test":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(actual, v) {
+			return nil, ErrTestFailed
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown operation %q: %w", op.Op, ErrInvalidPatch)
+}
+
+// ParsePointer returns the unescaped reference tokens of the JSON Pointer (RFC 6901) pointer
+func ParsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q, must start with /: %w", pointer, ErrInvalidPatch)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func get(doc any, path []string) (any, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]any:
+			child, ok := node[token]
+			if !ok {
+				return nil, notFound(token)
+			}
+			doc = child
+		case []any:
+			i, err := index(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[i]
+		default:
+			return nil, notFound(token)
+		}
+	}
+	return doc, nil
+}
+
+// add adds value at path in doc, replacing object members and inserting array elements
+func add(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return update(doc, path, func(container any, token string) (any, error) {
+		switch node := container.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			if token == "-" {
+				return append(node, value), nil
+			}
+			i, err := index(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:i], append([]any{value}, node[i:]...)...), nil
+		}
+		return nil, notFound(token)
+	})
+}
+
+// remove removes the value at path in doc, returning it
+func remove(doc any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("can't remove the whole document: %w", ErrInvalidPatch)
+	}
+	var removed any
+	doc, err := update(doc, path, func(container any, token string) (any, error) {
+		switch node := container.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, notFound(token)
+			}
+			removed = value
+			delete(node, token)
+			return node, nil
+		case []any:
+			i, err := index(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			removed = node[i]
+			return append(node[:i], node[i+1:]...), nil
+		}
+		return nil, notFound(token)
+	})
+	return doc, removed, err
+}
+
+// update replaces the container of the last token of path by the result of fn
+func update(doc any, path []string, fn func(container any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+	switch node := doc.(type) {
+	case map[string]any:
+		child, ok := node[path[0]]
+		if !ok {
+			return nil, notFound(path[0])
+		}
+		updated, err := update(child, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[path[0]] = updated
+		return node, nil
+	case []any:
+		i, err := index(path[0], len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := update(node[i], path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[i] = updated
+		return node, nil
+	}
+	return nil, notFound(path[0])
+}
+
+// index parses an array index token, at most max
+func index(token string, max int) (int, error) {
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') || token[0] == '+' {
+		return 0, fmt.Errorf("invalid array index %q: %w", token, ErrInvalidPatch)
+	}
+	if i > max {
+		return 0, fmt.Errorf("array index %d out of bounds: %w", i, ErrInvalidPatch)
+	}
+	return i, nil
+}
+
+func notFound(token string) error {
+	return fmt.Errorf("path not found at %q: %w", token, ErrInvalidPatch)
+}
+
+func deepCopy(doc any) any {
+	switch node := doc.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(node))
+		for key, value := range node {
+			copied[key] = deepCopy(value)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(node))
+		for i, value := range node {
+			copied[i] = deepCopy(value)
+		}
+		return copied
+	}
+	return doc
+}
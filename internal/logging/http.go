@@ -0,0 +1,129 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+// RequestIdHeader is the header carrying the request id, propagated when provided by the client
+const RequestIdHeader = "X-Request-Id"
+
+// maxRequestIdLength bounds client provided request ids, longer ones are replaced by a generated id
+const maxRequestIdLength = 128
+
+type requestIdCtxKey struct{}
+
+// WithRequestId returns a copy of ctx carrying the provided request id
+func WithRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdCtxKey{}, id)
+}
+
+// RequestId returns the request id carried by ctx, empty if none
+func RequestId(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdCtxKey{}).(string)
+	return id
+}
+
+// RequestIdMiddleware propagates the X-Request-Id header of the request, or generates a new id when missing or
+// invalid, storing it in the request context and setting it on the response, so that it's included in every
+// log line and in error responses
+func RequestIdMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIdHeader)
+		if !validRequestId(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set(RequestIdHeader, id)
+		next.ServeHTTP(w, r.WithContext(WithRequestId(r.Context(), id)))
+	})
+}
+
+// validRequestId accepts non-empty ids of printable ASCII characters only, so that they can be safely logged
+func validRequestId(id string) bool {
+	if id == "" || len(id) > maxRequestIdLength {
+		return false
+	}
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// AccessLog logs every request once served, with its status, size and duration. Server errors are logged as
+// errors, client errors as warnings.
+func AccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		slog.Log(r.Context(), level, "request served",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", status,
+			"bytes", ww.BytesWritten(),
+			"duration_ms", time.Since(start).Milliseconds(),
+			"remote_addr", r.RemoteAddr,
+		)
+	})
+}
+
+// levelBody is the JSON body of the log level endpoint
+type levelBody struct {
+	Level string `json:"level"`
+}
+
+// LevelHandler reports the current log level on GET and, when writable, changes it on PUT, e.g. with {"level":"debug"}
+func LevelHandler(level *slog.LevelVar, writable bool) http.Handler {
+	allow := "GET"
+	if writable {
+		allow = "GET, PUT"
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+		case r.Method == http.MethodPut && writable:
+			body := levelBody{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, "invalid body, expected {\"level\":\"<debug|info|warn|error>\"}", http.StatusBadRequest)
+				return
+			}
+			var newLevel slog.Level
+			if err := newLevel.UnmarshalText([]byte(body.Level)); err != nil {
+				http.Error(w, "invalid log level "+body.Level+", expected one of debug, info, warn or error", http.StatusBadRequest)
+				return
+			}
+			previous := level.Level()
+			level.Set(newLevel)
+			slog.InfoContext(r.Context(), "log level changed", "from", previous, "to", newLevel)
+		default:
+			w.Header().Set("Allow", allow)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(levelBody{Level: level.Level().String()}); err != nil {
+			slog.ErrorContext(r.Context(), "error encoding log level", "error", err)
+		}
+	})
+}
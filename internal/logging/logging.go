@@ -0,0 +1,81 @@
+// Package logging configures the structured log/slog logging of the Model Registry, attaching the request id
+// and trace id found in the context to every log record
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+
+	requestIdKey = "request_id"
+	traceIdKey   = "trace_id"
+)
+
+// Config holds the logging configuration
+type Config struct {
+	Format string // one of text or json
+	Level  string // one of debug, info, warn or error
+}
+
+// NewDefaultConfig returns the default logging configuration, info level text logs
+func NewDefaultConfig() Config {
+	return Config{
+		Format: FormatText,
+		Level:  "info",
+	}
+}
+
+// Setup installs the default slog logger writing to w, which the standard log package is redirected to as well.
+// The returned level can be changed at runtime, e.g. through LevelHandler.
+func Setup(cfg Config, w io.Writer) (*slog.LevelVar, error) {
+	level := &slog.LevelVar{}
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q, expected one of debug, info, warn or error", cfg.Level)
+	}
+
+	options := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case "", FormatText:
+		handler = slog.NewTextHandler(w, options)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, options)
+	default:
+		return nil, fmt.Errorf("unsupported log format %q, expected one of %s, %s", cfg.Format, FormatText, FormatJSON)
+	}
+
+	slog.SetDefault(slog.New(&contextHandler{Handler: handler}))
+	return level, nil
+}
+
+// contextHandler adds the request id and trace id found in the context to every record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestId(ctx); id != "" {
+		r.AddAttrs(slog.String(requestIdKey, id))
+	}
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		r.AddAttrs(slog.String(traceIdKey, spanCtx.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,113 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupJSONIncludesRequestId(t *testing.T) {
+	assertion := assert.New(t)
+	previous := slog.Default()
+	defer slog.SetDefault(previous)
+
+	out := &bytes.Buffer{}
+	level, err := Setup(Config{Format: FormatJSON, Level: "info"}, out)
+	assertion.Nil(err)
+
+	handler := RequestIdMiddleware(AccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slog.DebugContext(r.Context(), "not logged at info level")
+		w.WriteHeader(http.StatusNotFound)
+	})))
+	req := httptest.NewRequest(http.MethodGet, "/models/1", nil)
+	req.Header.Set(RequestIdHeader, "abc-123")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assertion.Equal("abc-123", rec.Header().Get(RequestIdHeader))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assertion.Len(lines, 1)
+	record := map[string]any{}
+	assertion.Nil(json.Unmarshal([]byte(lines[0]), &record))
+	assertion.Equal("request served", record["msg"])
+	assertion.Equal("WARN", record["level"])
+	assertion.Equal("abc-123", record["request_id"])
+	assertion.Equal(float64(http.StatusNotFound), record["status"])
+
+	level.Set(slog.LevelDebug)
+	out.Reset()
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assertion.Contains(out.String(), "not logged at info level")
+}
+
+func TestSetupRejectsInvalidConfig(t *testing.T) {
+	_, err := Setup(Config{Format: "xml", Level: "info"}, &bytes.Buffer{})
+	assert.NotNil(t, err)
+	_, err = Setup(Config{Format: FormatText, Level: "verbose"}, &bytes.Buffer{})
+	assert.NotNil(t, err)
+}
+
+func TestRequestIdMiddlewareGeneratesMissingOrInvalidIds(t *testing.T) {
+	assertion := assert.New(t)
+
+	var seen string
+	handler := RequestIdMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestId(r.Context())
+	}))
+
+	for _, provided := range []string{"", "with spaces", strings.Repeat("a", maxRequestIdLength+1)} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(RequestIdHeader, provided)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assertion.NotEmpty(seen)
+		assertion.NotEqual(provided, seen)
+		assertion.Equal(seen, rec.Header().Get(RequestIdHeader))
+	}
+}
+
+func TestLevelHandler(t *testing.T) {
+	assertion := assert.New(t)
+	level := &slog.LevelVar{}
+	handler := LevelHandler(level, true)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/log-level", nil))
+	assertion.Equal(http.StatusOK, rec.Code)
+	assertion.JSONEq(`{"level":"INFO"}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/log-level", strings.NewReader(`{"level":"debug"}`)))
+	assertion.Equal(http.StatusOK, rec.Code)
+	assertion.JSONEq(`{"level":"DEBUG"}`, rec.Body.String())
+	assertion.Equal(slog.LevelDebug, level.Level())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/log-level", strings.NewReader(`{"level":"loud"}`)))
+	assertion.Equal(http.StatusBadRequest, rec.Code)
+	assertion.Equal(slog.LevelDebug, level.Level())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/log-level", nil))
+	assertion.Equal(http.StatusMethodNotAllowed, rec.Code)
+
+	// read-only handlers only report the level
+	readOnly := LevelHandler(level, false)
+	rec = httptest.NewRecorder()
+	readOnly.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/log-level", nil))
+	assertion.Equal(http.StatusOK, rec.Code)
+	assertion.JSONEq(`{"level":"DEBUG"}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	readOnly.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/log-level", strings.NewReader(`{"level":"error"}`)))
+	assertion.Equal(http.StatusMethodNotAllowed, rec.Code)
+	assertion.Equal("GET", rec.Header().Get("Allow"))
+	assertion.Equal(slog.LevelDebug, level.Level())
+}
@@ -121,11 +121,15 @@ func (m *Mapper) MapToModelVersion(ctx *proto.Context) (*openapi.ModelVersion, e
 }
 
 func (m *Mapper) MapToModelArtifact(art *proto.Artifact) (*openapi.ModelArtifact, error) {
-	return mapTo(art, m.MLMDTypes, defaults.ModelArtifactTypeName, m.MLMDConverter.ConvertModelArtifact)
+	return mapTo(art, m.MLMDTypes, defaults.ModelArtifactTypeName, func(a *proto.Artifact) (*openapi.ModelArtifact, error) {
+		return m.MLMDConverter.ConvertModelArtifact(withLogicalType(a, defaults.ModelArtifactTypeName))
+	})
 }
 
 func (m *Mapper) MapToDocArtifact(art *proto.Artifact) (*openapi.DocArtifact, error) {
-	return mapTo(art, m.MLMDTypes, defaults.DocArtifactTypeName, m.MLMDConverter.ConvertDocArtifact)
+	return mapTo(art, m.MLMDTypes, defaults.DocArtifactTypeName, func(a *proto.Artifact) (*openapi.DocArtifact, error) {
+		return m.MLMDConverter.ConvertDocArtifact(withLogicalType(a, defaults.DocArtifactTypeName))
+	})
 }
 
 func (m *Mapper) MapToArtifact(art *proto.Artifact) (*openapi.Artifact, error) {
@@ -135,13 +139,14 @@ func (m *Mapper) MapToArtifact(art *proto.Artifact) (*openapi.Artifact, error) {
 	if art.GetType() == "" {
 		return nil, fmt.Errorf("invalid artifact type, can't map from nil")
 	}
-	switch art.GetType() {
-	case defaults.ModelArtifactTypeName:
+	// MLMD type names are configurable, hence artifacts are told apart by type id
+	switch art.GetTypeId() {
+	case m.MLMDTypes[defaults.ModelArtifactTypeName]:
 		ma, err := m.MapToModelArtifact(art)
 		return &openapi.Artifact{
 			ModelArtifact: ma,
 		}, err
-	case defaults.DocArtifactTypeName:
+	case m.MLMDTypes[defaults.DocArtifactTypeName]:
 		da, err := m.MapToDocArtifact(art)
 		return &openapi.Artifact{
 			DocArtifact: da,
@@ -163,6 +168,13 @@ func (m *Mapper) MapToServeModel(ex *proto.Execution) (*openapi.ServeModel, erro
 	return mapTo(ex, m.MLMDTypes, defaults.ServeModelTypeName, m.MLMDConverter.ConvertServeModel)
 }
 
+// withLogicalType replaces the MLMD type name of an artifact, whose type id has already been verified, with the
+// default one, as converters rely on the default type names to tell artifact types apart
+func withLogicalType(art *proto.Artifact, typeName string) *proto.Artifact {
+	art.Type = &typeName
+	return art
+}
+
 type getTypeIder interface {
 	GetTypeId() int64
 	GetType() string
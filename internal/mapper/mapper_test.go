@@ -200,6 +200,17 @@ func TestMapToModelArtifact(t *testing.T) {
 	assertion.Nil(err)
 }
 
+func TestMapToModelArtifactCustomTypeName(t *testing.T) {
+	assertion, m := setup(t)
+	artifact, err := m.MapToArtifact(&proto.Artifact{
+		TypeId: of(modelArtifactTypeId),
+		Type:   of("team.ModelArtifact"),
+	})
+	assertion.Nil(err)
+	assertion.NotNil(artifact.ModelArtifact)
+	assertion.Equal("model-artifact", artifact.ModelArtifact.ArtifactType)
+}
+
 func TestMapToModelArtifactMissingType(t *testing.T) {
 	assertion, m := setup(t)
 	_, err := m.MapToArtifact(&proto.Artifact{
@@ -0,0 +1,224 @@
+// Package migrations versions the Model Registry schema stored in MLMD, i.e. its types and the properties
+// of its entities, applying ordered migration steps and recording the resulting schema version in the store.
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/kubeflow/model-registry/internal/mlmdtypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// schemaContextName is the name of the single context, of the schema version type, recording the schema version
+	schemaContextName     = "model-registry"
+	versionProperty       = "version"
+	descriptionProperty   = "description"
+	schemaVersionTypeDesc = "Model Registry schema version"
+)
+
+// Step performs a migration, returning the actions performed, or only the actions it would perform when dryRun is set
+type Step func(ctx context.Context, m *Migrator, dryRun bool) ([]string, error)
+
+// Migration is a single step moving the schema from the previous version to Version
+type Migration struct {
+	Version     int
+	Description string
+	Apply       Step
+}
+
+// All lists the Model Registry migrations, ordered by version. Steps must be idempotent,
+// as a failure after applying a step but before recording its version re-applies it.
+var All = []Migration{
+	{
+		Version:     1,
+		Description: "create or update Model Registry types",
+		Apply:       ensureTypes,
+	},
+	{
+		Version:     2,
+		Description: "add the signature property to the ModelArtifact type",
+		Apply: AddProperties(ArtifactKind, func(c mlmdtypes.MLMDTypeNamesConfig) string { return c.ModelArtifactTypeName },
+			map[string]proto.PropertyType{"signature": proto.PropertyType_STRING}),
+	},
+	{
+		Version:     3,
+		Description: "add the digests property to the ModelArtifact type",
+		Apply: AddProperties(ArtifactKind, func(c mlmdtypes.MLMDTypeNamesConfig) string { return c.ModelArtifactTypeName },
+			map[string]proto.PropertyType{"digests": proto.PropertyType_STRING}),
+	},
+}
+
+// Latest returns the version of the last migration in All
+func Latest() int {
+	return All[len(All)-1].Version
+}
+
+// Status describes the schema version of a store
+type Status struct {
+	Current int         // version recorded in the store, 0 when none was recorded
+	Latest  int         // version of the last known migration
+	Pending []Migration // migrations not applied yet
+}
+
+// Result describes a migration applied, or planned when running in dry-run mode
+type Result struct {
+	Migration Migration
+	Actions   []string
+}
+
+// Migrator applies migrations to the MLMD store
+type Migrator struct {
+	client     proto.MetadataStoreServiceClient
+	cc         grpc.ClientConnInterface
+	nameConfig mlmdtypes.MLMDTypeNamesConfig
+	migrations []Migration
+}
+
+// NewMigrator creates a Migrator applying All migrations to the MLMD store reachable through cc
+func NewMigrator(cc grpc.ClientConnInterface, nameConfig mlmdtypes.MLMDTypeNamesConfig) *Migrator {
+	return &Migrator{
+		client:     proto.NewMetadataStoreServiceClient(cc),
+		cc:         cc,
+		nameConfig: nameConfig,
+		migrations: All,
+	}
+}
+
+// Status returns the current schema version of the store and the migrations pending
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	current, err := m.currentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest := 0
+	pending := []Migration{}
+	for _, migration := range m.migrations {
+		latest = migration.Version
+		if migration.Version > current {
+			pending = append(pending, migration)
+		}
+	}
+	if current > latest {
+		return nil, fmt.Errorf("store schema version %d is newer than the latest known version %d, please upgrade the model registry", current, latest)
+	}
+	return &Status{Current: current, Latest: latest, Pending: pending}, nil
+}
+
+// Migrate applies, in order, all pending migrations up to the target version, or the latest one when target is 0.
+// The schema version is recorded after each successful step. In dry-run mode nothing is written to the store,
+// and the returned results list the actions each step would perform.
+func (m *Migrator) Migrate(ctx context.Context, target int, dryRun bool) ([]Result, error) {
+	st, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if target == 0 {
+		target = st.Latest
+	}
+	if target < st.Current {
+		return nil, fmt.Errorf("cannot migrate from version %d down to %d, downgrades are not supported", st.Current, target)
+	}
+
+	results := []Result{}
+	for _, migration := range st.Pending {
+		if migration.Version > target {
+			break
+		}
+		slog.InfoContext(ctx, "applying migration", "version", migration.Version, "description", migration.Description, "dry_run", dryRun)
+		actions, err := migration.Apply(ctx, m, dryRun)
+		if err != nil {
+			return results, fmt.Errorf("error applying migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+		results = append(results, Result{Migration: migration, Actions: actions})
+		if dryRun {
+			continue
+		}
+		if err := m.recordVersion(ctx, migration); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// currentVersion reads the schema version recorded in the store, 0 if none was recorded yet
+func (m *Migrator) currentVersion(ctx context.Context) (int, error) {
+	resp, err := m.client.GetContextByTypeAndName(ctx, &proto.GetContextByTypeAndNameRequest{
+		TypeName:    &m.nameConfig.SchemaVersionTypeName,
+		ContextName: of(schemaContextName),
+	})
+	if status.Code(err) == codes.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error reading schema version: %w", err)
+	}
+	if resp.GetContext() == nil {
+		return 0, nil
+	}
+	return int(resp.GetContext().GetProperties()[versionProperty].GetIntValue()), nil
+}
+
+// recordVersion stores the version of the provided migration, creating the schema version type if needed
+func (m *Migrator) recordVersion(ctx context.Context, migration Migration) error {
+	typeResp, err := m.client.PutContextType(ctx, &proto.PutContextTypeRequest{
+		CanAddFields: of(true),
+		ContextType: &proto.ContextType{
+			Name:        &m.nameConfig.SchemaVersionTypeName,
+			Description: of(schemaVersionTypeDesc),
+			Properties: map[string]proto.PropertyType{
+				versionProperty:     proto.PropertyType_INT,
+				descriptionProperty: proto.PropertyType_STRING,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating schema version type %s: %w", m.nameConfig.SchemaVersionTypeName, err)
+	}
+
+	schemaCtx := &proto.Context{
+		TypeId: typeResp.TypeId,
+		Name:   of(schemaContextName),
+		Properties: map[string]*proto.Value{
+			versionProperty:     {Value: &proto.Value_IntValue{IntValue: int64(migration.Version)}},
+			descriptionProperty: {Value: &proto.Value_StringValue{StringValue: migration.Description}},
+		},
+	}
+	existing, err := m.client.GetContextByTypeAndName(ctx, &proto.GetContextByTypeAndNameRequest{
+		TypeName:    &m.nameConfig.SchemaVersionTypeName,
+		ContextName: of(schemaContextName),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading schema version: %w", err)
+	}
+	if existing.GetContext() != nil {
+		schemaCtx.Id = existing.GetContext().Id
+	}
+	if _, err := m.client.PutContexts(ctx, &proto.PutContextsRequest{Contexts: []*proto.Context{schemaCtx}}); err != nil {
+		return fmt.Errorf("error recording schema version %d: %w", migration.Version, err)
+	}
+	return nil
+}
+
+// ensureTypes creates the Model Registry types, or adds their missing properties, as defined by mlmdtypes
+func ensureTypes(ctx context.Context, m *Migrator, dryRun bool) ([]string, error) {
+	if dryRun {
+		if _, err := mlmdtypes.ValidateMLMDTypes(m.cc, m.nameConfig); err != nil {
+			return []string{fmt.Sprintf("create or update types to fix: %v", err)}, nil
+		}
+		return []string{}, nil
+	}
+	if _, err := mlmdtypes.CreateMLMDTypes(m.cc, m.nameConfig); err != nil {
+		return nil, err
+	}
+	return []string{"created or updated Model Registry types"}, nil
+}
+
+func of[T any](v T) *T {
+	return &v
+}
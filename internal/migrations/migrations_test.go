@@ -0,0 +1,203 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/kubeflow/model-registry/internal/mlmdtypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStore is an in-memory MLMD store only supporting the context operations used by migrations
+type fakeStore struct {
+	proto.MetadataStoreServiceClient
+	types    map[string]*proto.ContextType
+	contexts map[int64]*proto.Context
+	puts     int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		types:    map[string]*proto.ContextType{},
+		contexts: map[int64]*proto.Context{},
+	}
+}
+
+func (f *fakeStore) PutContextType(ctx context.Context, in *proto.PutContextTypeRequest, opts ...grpc.CallOption) (*proto.PutContextTypeResponse, error) {
+	existing, ok := f.types[in.ContextType.GetName()]
+	if !ok {
+		existing = &proto.ContextType{Id: of(int64(len(f.types) + 1)), Name: in.ContextType.Name, Properties: map[string]proto.PropertyType{}}
+		f.types[in.ContextType.GetName()] = existing
+	}
+	for k, v := range in.ContextType.Properties {
+		existing.Properties[k] = v
+	}
+	return &proto.PutContextTypeResponse{TypeId: existing.Id}, nil
+}
+
+func (f *fakeStore) GetContextType(ctx context.Context, in *proto.GetContextTypeRequest, opts ...grpc.CallOption) (*proto.GetContextTypeResponse, error) {
+	existing, ok := f.types[in.GetTypeName()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "type not found")
+	}
+	return &proto.GetContextTypeResponse{ContextType: existing}, nil
+}
+
+func (f *fakeStore) typeName(typeId int64) string {
+	for name, t := range f.types {
+		if t.GetId() == typeId {
+			return name
+		}
+	}
+	return ""
+}
+
+func (f *fakeStore) GetContextByTypeAndName(ctx context.Context, in *proto.GetContextByTypeAndNameRequest, opts ...grpc.CallOption) (*proto.GetContextByTypeAndNameResponse, error) {
+	if _, ok := f.types[in.GetTypeName()]; !ok {
+		return nil, status.Error(codes.NotFound, "type not found")
+	}
+	for _, c := range f.contexts {
+		if f.typeName(c.GetTypeId()) == in.GetTypeName() && c.GetName() == in.GetContextName() {
+			return &proto.GetContextByTypeAndNameResponse{Context: c}, nil
+		}
+	}
+	return &proto.GetContextByTypeAndNameResponse{}, nil
+}
+
+func (f *fakeStore) GetContextsByType(ctx context.Context, in *proto.GetContextsByTypeRequest, opts ...grpc.CallOption) (*proto.GetContextsByTypeResponse, error) {
+	resp := &proto.GetContextsByTypeResponse{}
+	for _, c := range f.contexts {
+		if f.typeName(c.GetTypeId()) == in.GetTypeName() {
+			resp.Contexts = append(resp.Contexts, c)
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeStore) PutContexts(ctx context.Context, in *proto.PutContextsRequest, opts ...grpc.CallOption) (*proto.PutContextsResponse, error) {
+	f.puts++
+	resp := &proto.PutContextsResponse{}
+	for _, c := range in.Contexts {
+		if c.Id == nil {
+			c.Id = of(int64(len(f.contexts) + 100))
+		}
+		f.contexts[c.GetId()] = c
+		resp.ContextIds = append(resp.ContextIds, c.GetId())
+	}
+	return resp, nil
+}
+
+func setup(t *testing.T, migrations ...Migration) (*assert.Assertions, *fakeStore, *Migrator) {
+	store := newFakeStore()
+	return assert.New(t), store, &Migrator{
+		client:     store,
+		nameConfig: mlmdtypes.NewMLMDTypeNamesConfigFromDefaults(),
+		migrations: migrations,
+	}
+}
+
+func registeredModelTypeName(cfg mlmdtypes.MLMDTypeNamesConfig) string {
+	return cfg.RegisteredModelTypeName
+}
+
+func TestMigrateRecordsVersionAndSkipsApplied(t *testing.T) {
+	applied := []int{}
+	step := func(version int) Step {
+		return func(ctx context.Context, m *Migrator, dryRun bool) ([]string, error) {
+			applied = append(applied, version)
+			return []string{}, nil
+		}
+	}
+	assertion, _, migrator := setup(t,
+		Migration{Version: 1, Description: "first", Apply: step(1)},
+		Migration{Version: 2, Description: "second", Apply: step(2)},
+	)
+
+	st, err := migrator.Status(context.Background())
+	assertion.Nil(err)
+	assertion.Equal(0, st.Current)
+	assertion.Equal(2, st.Latest)
+	assertion.Len(st.Pending, 2)
+
+	_, err = migrator.Migrate(context.Background(), 1, false)
+	assertion.Nil(err)
+	st, err = migrator.Status(context.Background())
+	assertion.Nil(err)
+	assertion.Equal(1, st.Current)
+	assertion.Len(st.Pending, 1)
+
+	results, err := migrator.Migrate(context.Background(), 0, false)
+	assertion.Nil(err)
+	assertion.Len(results, 1)
+	assertion.Equal([]int{1, 2}, applied)
+
+	st, err = migrator.Status(context.Background())
+	assertion.Nil(err)
+	assertion.Equal(2, st.Current)
+	assertion.Empty(st.Pending)
+
+	_, err = migrator.Migrate(context.Background(), 1, false)
+	assertion.NotNil(err, "downgrades should be rejected")
+}
+
+func TestMigrateDryRunDoesNotWrite(t *testing.T) {
+	assertion, store, migrator := setup(t, Migration{
+		Version:     1,
+		Description: "add owner",
+		Apply:       AddProperties(ContextKind, registeredModelTypeName, map[string]proto.PropertyType{"owner": proto.PropertyType_STRING}),
+	})
+	_, err := store.PutContextType(context.Background(), &proto.PutContextTypeRequest{ContextType: &proto.ContextType{
+		Name:       of("kf.RegisteredModel"),
+		Properties: map[string]proto.PropertyType{"description": proto.PropertyType_STRING},
+	}})
+	assertion.Nil(err)
+
+	results, err := migrator.Migrate(context.Background(), 0, true)
+	assertion.Nil(err)
+	assertion.Equal([]string{"add property owner of type STRING to context type kf.RegisteredModel"}, results[0].Actions)
+	assertion.NotContains(store.types["kf.RegisteredModel"].Properties, "owner")
+
+	st, err := migrator.Status(context.Background())
+	assertion.Nil(err)
+	assertion.Equal(0, st.Current)
+
+	_, err = migrator.Migrate(context.Background(), 0, false)
+	assertion.Nil(err)
+	assertion.Contains(store.types["kf.RegisteredModel"].Properties, "owner")
+}
+
+func TestBackfillFromCustomProperty(t *testing.T) {
+	assertion, store, migrator := setup(t)
+	typeResp, err := store.PutContextType(context.Background(), &proto.PutContextTypeRequest{ContextType: &proto.ContextType{
+		Name:       of("kf.RegisteredModel"),
+		Properties: map[string]proto.PropertyType{"owner": proto.PropertyType_STRING},
+	}})
+	assertion.Nil(err)
+	_, err = store.PutContexts(context.Background(), &proto.PutContextsRequest{Contexts: []*proto.Context{
+		{
+			Id:               of(int64(1)),
+			TypeId:           typeResp.TypeId,
+			CustomProperties: map[string]*proto.Value{"owner": {Value: &proto.Value_StringValue{StringValue: "alice"}}},
+		},
+		{
+			Id:         of(int64(2)),
+			TypeId:     typeResp.TypeId,
+			Properties: map[string]*proto.Value{"owner": {Value: &proto.Value_StringValue{StringValue: "bob"}}},
+		},
+	}})
+	assertion.Nil(err)
+	store.puts = 0
+
+	step := BackfillFromCustomProperty(ContextKind, registeredModelTypeName, "owner", "owner")
+	actions, err := step(context.Background(), migrator, false)
+	assertion.Nil(err)
+	assertion.Equal([]string{"move custom property owner of context 1 to property owner"}, actions)
+	assertion.Equal(1, store.puts)
+	assertion.Equal("alice", store.contexts[1].Properties["owner"].GetStringValue())
+	assertion.NotContains(store.contexts[1].CustomProperties, "owner")
+	assertion.Equal("bob", store.contexts[2].Properties["owner"].GetStringValue())
+}
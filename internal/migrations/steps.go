@@ -0,0 +1,214 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/kubeflow/model-registry/internal/mlmdtypes"
+)
+
+// Kind is the kind of MLMD node a Model Registry type is made of
+type Kind string
+
+const (
+	ContextKind   Kind = "context"
+	ArtifactKind  Kind = "artifact"
+	ExecutionKind Kind = "execution"
+)
+
+// TypeName selects a type name from the configured ones, e.g. the ModelArtifact one
+type TypeName func(mlmdtypes.MLMDTypeNamesConfig) string
+
+// pageSize is the number of entities read at once when backfilling, the MLMD upper bound
+const pageSize = int32(100)
+
+// AddProperties returns a step adding the provided properties to an existing type, properties already
+// defined are left as they are
+func AddProperties(kind Kind, typeName TypeName, properties map[string]proto.PropertyType) Step {
+	return func(ctx context.Context, m *Migrator, dryRun bool) ([]string, error) {
+		name := typeName(m.nameConfig)
+		existing, err := m.typeProperties(ctx, kind, name)
+		if err != nil {
+			return nil, err
+		}
+
+		missing := map[string]proto.PropertyType{}
+		actions := []string{}
+		for _, property := range sortedKeys(properties) {
+			if _, ok := existing[property]; ok {
+				continue
+			}
+			missing[property] = properties[property]
+			actions = append(actions, fmt.Sprintf("add property %s of type %s to %s type %s", property, properties[property], kind, name))
+		}
+		if dryRun || len(missing) == 0 {
+			return actions, nil
+		}
+
+		for property, propertyType := range missing {
+			existing[property] = propertyType
+		}
+		if err := m.putTypeProperties(ctx, kind, name, existing); err != nil {
+			return nil, err
+		}
+		return actions, nil
+	}
+}
+
+// BackfillFromCustomProperty returns a step moving the value of a custom property into a property, on every
+// entity of the provided type having the custom property set and the property unset
+func BackfillFromCustomProperty(kind Kind, typeName TypeName, customProperty string, property string) Step {
+	return func(ctx context.Context, m *Migrator, dryRun bool) ([]string, error) {
+		name := typeName(m.nameConfig)
+		actions := []string{}
+		backfill := func(id int64, properties, customProperties map[string]*proto.Value) (bool, map[string]*proto.Value, map[string]*proto.Value) {
+			value, ok := customProperties[customProperty]
+			if !ok {
+				return false, properties, customProperties
+			}
+			if _, ok := properties[property]; ok {
+				return false, properties, customProperties
+			}
+			actions = append(actions, fmt.Sprintf("move custom property %s of %s %d to property %s", customProperty, kind, id, property))
+			if properties == nil {
+				properties = map[string]*proto.Value{}
+			}
+			properties[property] = value
+			delete(customProperties, customProperty)
+			return true, properties, customProperties
+		}
+
+		var nextPageToken *string
+		for {
+			options := &proto.ListOperationOptions{MaxResultSize: of(pageSize), NextPageToken: nextPageToken}
+			var err error
+			switch kind {
+			case ContextKind:
+				var resp *proto.GetContextsByTypeResponse
+				resp, err = m.client.GetContextsByType(ctx, &proto.GetContextsByTypeRequest{TypeName: &name, Options: options})
+				if err == nil {
+					changed := []*proto.Context{}
+					for _, c := range resp.GetContexts() {
+						var ok bool
+						if ok, c.Properties, c.CustomProperties = backfill(c.GetId(), c.Properties, c.CustomProperties); ok {
+							changed = append(changed, c)
+						}
+					}
+					if len(changed) > 0 && !dryRun {
+						_, err = m.client.PutContexts(ctx, &proto.PutContextsRequest{Contexts: changed})
+					}
+					nextPageToken = resp.NextPageToken
+				}
+			case ArtifactKind:
+				var resp *proto.GetArtifactsByTypeResponse
+				resp, err = m.client.GetArtifactsByType(ctx, &proto.GetArtifactsByTypeRequest{TypeName: &name, Options: options})
+				if err == nil {
+					changed := []*proto.Artifact{}
+					for _, a := range resp.GetArtifacts() {
+						var ok bool
+						if ok, a.Properties, a.CustomProperties = backfill(a.GetId(), a.Properties, a.CustomProperties); ok {
+							changed = append(changed, a)
+						}
+					}
+					if len(changed) > 0 && !dryRun {
+						_, err = m.client.PutArtifacts(ctx, &proto.PutArtifactsRequest{Artifacts: changed})
+					}
+					nextPageToken = resp.NextPageToken
+				}
+			case ExecutionKind:
+				var resp *proto.GetExecutionsByTypeResponse
+				resp, err = m.client.GetExecutionsByType(ctx, &proto.GetExecutionsByTypeRequest{TypeName: &name, Options: options})
+				if err == nil {
+					changed := []*proto.Execution{}
+					for _, e := range resp.GetExecutions() {
+						var ok bool
+						if ok, e.Properties, e.CustomProperties = backfill(e.GetId(), e.Properties, e.CustomProperties); ok {
+							changed = append(changed, e)
+						}
+					}
+					if len(changed) > 0 && !dryRun {
+						_, err = m.client.PutExecutions(ctx, &proto.PutExecutionsRequest{Executions: changed})
+					}
+					nextPageToken = resp.NextPageToken
+				}
+			default:
+				return nil, fmt.Errorf("unknown kind %s", kind)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error backfilling property %s of %s type %s: %w", property, kind, name, err)
+			}
+			if nextPageToken == nil || *nextPageToken == "" {
+				return actions, nil
+			}
+		}
+	}
+}
+
+// typeProperties returns the properties of the named type
+func (m *Migrator) typeProperties(ctx context.Context, kind Kind, name string) (map[string]proto.PropertyType, error) {
+	var properties map[string]proto.PropertyType
+	var err error
+	switch kind {
+	case ContextKind:
+		var resp *proto.GetContextTypeResponse
+		resp, err = m.client.GetContextType(ctx, &proto.GetContextTypeRequest{TypeName: &name})
+		properties = resp.GetContextType().GetProperties()
+	case ArtifactKind:
+		var resp *proto.GetArtifactTypeResponse
+		resp, err = m.client.GetArtifactType(ctx, &proto.GetArtifactTypeRequest{TypeName: &name})
+		properties = resp.GetArtifactType().GetProperties()
+	case ExecutionKind:
+		var resp *proto.GetExecutionTypeResponse
+		resp, err = m.client.GetExecutionType(ctx, &proto.GetExecutionTypeRequest{TypeName: &name})
+		properties = resp.GetExecutionType().GetProperties()
+	default:
+		return nil, fmt.Errorf("unknown kind %s", kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s type %s: %w", kind, name, err)
+	}
+	copied := make(map[string]proto.PropertyType, len(properties))
+	for k, v := range properties {
+		copied[k] = v
+	}
+	return copied, nil
+}
+
+// putTypeProperties updates the named type with the provided properties, which must include the existing ones
+func (m *Migrator) putTypeProperties(ctx context.Context, kind Kind, name string, properties map[string]proto.PropertyType) error {
+	var err error
+	switch kind {
+	case ContextKind:
+		_, err = m.client.PutContextType(ctx, &proto.PutContextTypeRequest{
+			CanAddFields: of(true),
+			ContextType:  &proto.ContextType{Name: &name, Properties: properties},
+		})
+	case ArtifactKind:
+		_, err = m.client.PutArtifactType(ctx, &proto.PutArtifactTypeRequest{
+			CanAddFields: of(true),
+			ArtifactType: &proto.ArtifactType{Name: &name, Properties: properties},
+		})
+	case ExecutionKind:
+		_, err = m.client.PutExecutionType(ctx, &proto.PutExecutionTypeRequest{
+			CanAddFields:  of(true),
+			ExecutionType: &proto.ExecutionType{Name: &name, Properties: properties},
+		})
+	default:
+		return fmt.Errorf("unknown kind %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("error updating %s type %s: %w", kind, name, err)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,223 @@
+// Package mlmdconn dials the gRPC connection to MLMD, with optional TLS, startup backoff,
+// retries of idempotent reads, keepalive and per-call deadlines.
+package mlmdconn
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/model-registry/internal/certs"
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Config holds the MLMD connection settings
+type Config struct {
+	// TLS
+	TLS        bool   // connect to MLMD over TLS
+	CAFile     string // optional, PEM CA bundle verifying the MLMD server, system roots otherwise
+	CertFile   string // optional, PEM client certificate for mTLS, reloaded when rotated
+	KeyFile    string // optional, PEM client key for mTLS, reloaded when rotated
+	ServerName string // optional, overrides the server name used to verify the MLMD certificate
+
+	// startup
+	ConnectTimeout time.Duration // timeout of each connection attempt
+	StartupTimeout time.Duration // overall time spent retrying the connection at startup, 0 retries forever
+	InitialBackoff time.Duration // delay before the first connection retry, doubled on every attempt
+	MaxBackoff     time.Duration // upper bound of the delay between connection retries
+
+	// per-RPC
+	CallTimeout         time.Duration // deadline of every MLMD call lacking one, 0 disables it
+	RetryMaxAttempts    int           // attempts of idempotent reads failing with UNAVAILABLE, 1 disables retries
+	RetryInitialBackoff time.Duration
+	RetryMaxBackoff     time.Duration
+
+	// keepalive
+	KeepaliveTime    time.Duration // ping MLMD after this much inactivity, 0 disables keepalive
+	KeepaliveTimeout time.Duration // close the connection if a ping is not acknowledged in time
+}
+
+// NewDefaultConfig returns the default MLMD connection settings
+func NewDefaultConfig() Config {
+	return Config{
+		ConnectTimeout:      30 * time.Second,
+		StartupTimeout:      5 * time.Minute,
+		InitialBackoff:      time.Second,
+		MaxBackoff:          30 * time.Second,
+		CallTimeout:         30 * time.Second,
+		RetryMaxAttempts:    4,
+		RetryInitialBackoff: 100 * time.Millisecond,
+		RetryMaxBackoff:     2 * time.Second,
+		KeepaliveTime:       30 * time.Second,
+		KeepaliveTimeout:    10 * time.Second,
+	}
+}
+
+// Dial connects to the MLMD server at addr, retrying with exponential backoff until it is reachable,
+// StartupTimeout expires or ctx is done. Additional dial options, e.g. interceptors, can be provided.
+func Dial(ctx context.Context, addr string, cfg Config, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	dialOpts, err := DialOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dialOpts = append(dialOpts, opts...)
+
+	if cfg.StartupTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.StartupTimeout)
+		defer cancel()
+	}
+
+	backoff := cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		conn, err := dialOnce(ctx, addr, cfg.ConnectTimeout, dialOpts)
+		if err == nil {
+			return conn, nil
+		}
+		slog.WarnContext(ctx, "error connecting to MLMD server, retrying", "address", addr, "attempt", attempt, "backoff", backoff, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("giving up connecting to MLMD server %s after %d attempts: %w", addr, attempt, err)
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, cfg.MaxBackoff)
+	}
+}
+
+func dialOnce(ctx context.Context, addr string, timeout time.Duration, opts []grpc.DialOption) (*grpc.ClientConn, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return grpc.DialContext(ctx, addr, append(opts, grpc.WithReturnConnectionError(), grpc.WithBlock())...)
+}
+
+// DialOptions returns the gRPC dial options implementing the provided configuration
+func DialOptions(cfg Config) ([]grpc.DialOption, error) {
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	serviceConfig, err := retryServiceConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(callTimeoutInterceptor(cfg.CallTimeout)),
+	}
+	if cfg.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: cfg.KeepaliveTimeout,
+		}))
+	}
+	return opts, nil
+}
+
+func transportCredentials(cfg Config) (credentials.TransportCredentials, error) {
+	if !cfg.TLS {
+		if cfg.CAFile != "" || cfg.CertFile != "" || cfg.KeyFile != "" {
+			return nil, fmt.Errorf("MLMD CA, certificate and key files require TLS to be enabled")
+		}
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+	if cfg.CAFile != "" || cfg.CertFile != "" {
+		reloader, err := certs.NewReloader(cfg.CertFile, cfg.KeyFile, cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading MLMD TLS material: %w", err)
+		}
+		tlsConfig.RootCAs = reloader.CAPool()
+		if cfg.CertFile != "" {
+			tlsConfig.GetClientCertificate = reloader.GetClientCertificate
+		}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type serviceConfig struct {
+	MethodConfig []methodConfig `json:"methodConfig"`
+}
+
+// retryServiceConfig builds a gRPC service config retrying the idempotent MLMD reads, i.e. all Get* methods
+func retryServiceConfig(cfg Config) (string, error) {
+	sc := serviceConfig{MethodConfig: []methodConfig{}}
+	if cfg.RetryMaxAttempts > 1 {
+		names := []methodName{}
+		for _, method := range proto.MetadataStoreService_ServiceDesc.Methods {
+			if strings.HasPrefix(method.MethodName, "Get") {
+				names = append(names, methodName{
+					Service: proto.MetadataStoreService_ServiceDesc.ServiceName,
+					Method:  method.MethodName,
+				})
+			}
+		}
+		sc.MethodConfig = append(sc.MethodConfig, methodConfig{
+			Name: names,
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          cfg.RetryMaxAttempts,
+				InitialBackoff:       durationString(cfg.RetryInitialBackoff),
+				MaxBackoff:           durationString(cfg.RetryMaxBackoff),
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		})
+	}
+	b, err := json.Marshal(sc)
+	if err != nil {
+		return "", fmt.Errorf("error building MLMD service config: %w", err)
+	}
+	return string(b), nil
+}
+
+// durationString formats a duration as expected by the gRPC service config, i.e. seconds with an s suffix
+func durationString(d time.Duration) string {
+	return fmt.Sprintf("%.3fs", d.Seconds())
+}
+
+// callTimeoutInterceptor sets a deadline on every call whose context lacks one
+func callTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
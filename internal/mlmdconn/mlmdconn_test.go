@@ -0,0 +1,92 @@
+package mlmdconn
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestRetryServiceConfigOnlyCoversReads(t *testing.T) {
+	assertion := assert.New(t)
+
+	cfg := NewDefaultConfig()
+	raw, err := retryServiceConfig(cfg)
+	assertion.Nil(err)
+
+	sc := serviceConfig{}
+	assertion.Nil(json.Unmarshal([]byte(raw), &sc))
+	assertion.Len(sc.MethodConfig, 1)
+	assertion.Equal(cfg.RetryMaxAttempts, sc.MethodConfig[0].RetryPolicy.MaxAttempts)
+
+	methods := map[string]bool{}
+	for _, name := range sc.MethodConfig[0].Name {
+		assertion.Equal("ml_metadata.MetadataStoreService", name.Service)
+		methods[name.Method] = true
+	}
+	assertion.True(methods["GetContextsByID"])
+	assertion.True(methods["GetArtifactsByContext"])
+	assertion.False(methods["PutContexts"])
+}
+
+func TestRetryServiceConfigDisabled(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.RetryMaxAttempts = 1
+	raw, err := retryServiceConfig(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"methodConfig":[]}`, raw)
+}
+
+func TestTransportCredentialsRequireTLSForFiles(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.CAFile = "ca.crt"
+	_, err := DialOptions(cfg)
+	assert.NotNil(t, err)
+}
+
+func TestCallTimeoutInterceptorSetsMissingDeadline(t *testing.T) {
+	assertion := assert.New(t)
+	interceptor := callTimeoutInterceptor(time.Minute)
+
+	var deadline time.Time
+	var hasDeadline bool
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, hasDeadline = ctx.Deadline()
+		return nil
+	}
+
+	assertion.Nil(interceptor(context.Background(), "/svc/Method", nil, nil, nil, invoker))
+	assertion.True(hasDeadline)
+	assertion.WithinDuration(time.Now().Add(time.Minute), deadline, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assertion.Nil(interceptor(ctx, "/svc/Method", nil, nil, nil, invoker))
+	assertion.WithinDuration(time.Now().Add(time.Second), deadline, time.Second)
+}
+
+func TestDialGivesUpAfterStartupTimeout(t *testing.T) {
+	assertion := assert.New(t)
+
+	// reserve a port and release it, so that nothing is listening there
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assertion.Nil(err)
+	addr := listener.Addr().String()
+	assertion.Nil(listener.Close())
+
+	cfg := NewDefaultConfig()
+	cfg.ConnectTimeout = 50 * time.Millisecond
+	cfg.StartupTimeout = 300 * time.Millisecond
+	cfg.InitialBackoff = 10 * time.Millisecond
+	cfg.MaxBackoff = 50 * time.Millisecond
+
+	start := time.Now()
+	_, err = Dial(context.Background(), addr, cfg)
+	assertion.NotNil(err)
+	assertion.Contains(err.Error(), "giving up")
+	assertion.Less(time.Since(start), 5*time.Second)
+}
@@ -17,6 +17,7 @@ type MLMDTypeNamesConfig struct {
 	ServingEnvironmentTypeName string
 	InferenceServiceTypeName   string
 	ServeModelTypeName         string
+	SchemaVersionTypeName      string
 	CanAddFields               bool
 }
 
@@ -29,15 +30,24 @@ func NewMLMDTypeNamesConfigFromDefaults() MLMDTypeNamesConfig {
 		ServingEnvironmentTypeName: defaults.ServingEnvironmentTypeName,
 		InferenceServiceTypeName:   defaults.InferenceServiceTypeName,
 		ServeModelTypeName:         defaults.ServeModelTypeName,
+		SchemaVersionTypeName:      defaults.SchemaVersionTypeName,
 		CanAddFields:               true,
 	}
 }
 
-// Utility method that created the necessary Model Registry's logical-model types
-// as the necessary MLMD's Context, Artifact, Execution types etc. in the underlying MLMD service
-func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig) (map[string]int64, error) {
-	client := proto.NewMetadataStoreServiceClient(cc)
+// typeRequests holds the MLMD requests creating every Model Registry type
+type typeRequests struct {
+	registeredModel    *proto.PutContextTypeRequest
+	modelVersion       *proto.PutContextTypeRequest
+	docArtifact        *proto.PutArtifactTypeRequest
+	modelArtifact      *proto.PutArtifactTypeRequest
+	servingEnvironment *proto.PutContextTypeRequest
+	inferenceService   *proto.PutContextTypeRequest
+	serveModel         *proto.PutExecutionTypeRequest
+}
 
+// newTypeRequests builds the MLMD requests creating the Model Registry types named after the provided config
+func newTypeRequests(nameConfig MLMDTypeNamesConfig) typeRequests {
 	registeredModelReq := proto.PutContextTypeRequest{
 		CanAddFields: &nameConfig.CanAddFields,
 		ContextType: &proto.ContextType{
@@ -46,6 +56,7 @@ func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig
 				"description": proto.PropertyType_STRING,
 				"owner":       proto.PropertyType_STRING,
 				"state":       proto.PropertyType_STRING,
+				"tenant":      proto.PropertyType_STRING,
 			},
 		},
 	}
@@ -60,6 +71,7 @@ func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig
 				"version":     proto.PropertyType_STRING,
 				"author":      proto.PropertyType_STRING,
 				"state":       proto.PropertyType_STRING,
+				"tenant":      proto.PropertyType_STRING,
 			},
 		},
 	}
@@ -70,6 +82,7 @@ func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig
 			Name: &nameConfig.DocArtifactTypeName,
 			Properties: map[string]proto.PropertyType{
 				"description": proto.PropertyType_STRING,
+				"tenant":      proto.PropertyType_STRING,
 			},
 		},
 	}
@@ -80,11 +93,14 @@ func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig
 			Name: &nameConfig.ModelArtifactTypeName,
 			Properties: map[string]proto.PropertyType{
 				"description":          proto.PropertyType_STRING,
+				"digests":              proto.PropertyType_STRING,
 				"model_format_name":    proto.PropertyType_STRING,
 				"model_format_version": proto.PropertyType_STRING,
 				"storage_key":          proto.PropertyType_STRING,
 				"storage_path":         proto.PropertyType_STRING,
 				"service_account_name": proto.PropertyType_STRING,
+				"signature":            proto.PropertyType_STRING,
+				"tenant":               proto.PropertyType_STRING,
 			},
 		},
 	}
@@ -95,6 +111,7 @@ func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig
 			Name: &nameConfig.ServingEnvironmentTypeName,
 			Properties: map[string]proto.PropertyType{
 				"description": proto.PropertyType_STRING,
+				"tenant":      proto.PropertyType_STRING,
 			},
 		},
 	}
@@ -111,6 +128,7 @@ func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig
 				"serving_environment_id": proto.PropertyType_INT,
 				"runtime":                proto.PropertyType_STRING,
 				"desired_state":          proto.PropertyType_STRING,
+				"tenant":                 proto.PropertyType_STRING,
 			},
 		},
 	}
@@ -122,41 +140,59 @@ func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig
 			Properties: map[string]proto.PropertyType{
 				"description":      proto.PropertyType_STRING,
 				"model_version_id": proto.PropertyType_INT,
+				"tenant":           proto.PropertyType_STRING,
 			},
 		},
 	}
 
-	registeredModelResp, err := client.PutContextType(context.Background(), &registeredModelReq)
+	return typeRequests{
+		registeredModel:    &registeredModelReq,
+		modelVersion:       &modelVersionReq,
+		docArtifact:        &docArtifactReq,
+		modelArtifact:      &modelArtifactReq,
+		servingEnvironment: &servingEnvironmentReq,
+		inferenceService:   &inferenceServiceReq,
+		serveModel:         &serveModelReq,
+	}
+}
+
+// Utility method that created the necessary Model Registry's logical-model types
+// as the necessary MLMD's Context, Artifact, Execution types etc. in the underlying MLMD service
+func CreateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig) (map[string]int64, error) {
+	client := proto.NewMetadataStoreServiceClient(cc)
+	reqs := newTypeRequests(nameConfig)
+
+	registeredModelResp, err := client.PutContextType(context.Background(), reqs.registeredModel)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up context type %s: %v", nameConfig.RegisteredModelTypeName, err)
 	}
 
-	modelVersionResp, err := client.PutContextType(context.Background(), &modelVersionReq)
+	modelVersionResp, err := client.PutContextType(context.Background(), reqs.modelVersion)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up context type %s: %v", nameConfig.ModelVersionTypeName, err)
 	}
 
-	docArtifactResp, err := client.PutArtifactType(context.Background(), &docArtifactReq)
+	docArtifactResp, err := client.PutArtifactType(context.Background(), reqs.docArtifact)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up artifact type %s: %v", nameConfig.DocArtifactTypeName, err)
 	}
 
-	modelArtifactResp, err := client.PutArtifactType(context.Background(), &modelArtifactReq)
+	modelArtifactResp, err := client.PutArtifactType(context.Background(), reqs.modelArtifact)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up artifact type %s: %v", nameConfig.ModelArtifactTypeName, err)
 	}
 
-	servingEnvironmentResp, err := client.PutContextType(context.Background(), &servingEnvironmentReq)
+	servingEnvironmentResp, err := client.PutContextType(context.Background(), reqs.servingEnvironment)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up context type %s: %v", nameConfig.ServingEnvironmentTypeName, err)
 	}
 
-	inferenceServiceResp, err := client.PutContextType(context.Background(), &inferenceServiceReq)
+	inferenceServiceResp, err := client.PutContextType(context.Background(), reqs.inferenceService)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up context type %s: %v", nameConfig.InferenceServiceTypeName, err)
 	}
 
-	serveModelResp, err := client.PutExecutionType(context.Background(), &serveModelReq)
+	serveModelResp, err := client.PutExecutionType(context.Background(), reqs.serveModel)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up execution type %s: %v", nameConfig.ServeModelTypeName, err)
 	}
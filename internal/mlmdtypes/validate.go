@@ -0,0 +1,97 @@
+package mlmdtypes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubeflow/model-registry/internal/defaults"
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidateMLMDTypes verifies the Model Registry types already exist in the underlying MLMD service, without
+// creating or altering them. All missing types, missing properties and mismatched property types are reported
+// in the returned error. Types may declare additional properties, e.g. when shared with another registry.
+func ValidateMLMDTypes(cc grpc.ClientConnInterface, nameConfig MLMDTypeNamesConfig) (map[string]int64, error) {
+	client := proto.NewMetadataStoreServiceClient(cc)
+	reqs := newTypeRequests(nameConfig)
+	ctx := context.Background()
+
+	typesMap := map[string]int64{}
+	problems := []string{}
+	check := func(logicalName, kind, name string, expected map[string]proto.PropertyType, get func() (int64, map[string]proto.PropertyType, error)) error {
+		id, actual, err := get()
+		if status.Code(err) == codes.NotFound {
+			problems = append(problems, fmt.Sprintf("missing %s type %s", kind, name))
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error getting %s type %s: %w", kind, name, err)
+		}
+		typesMap[logicalName] = id
+		problems = append(problems, propertyProblems(kind, name, expected, actual)...)
+		return nil
+	}
+
+	checkContextType := func(logicalName string, req *proto.PutContextTypeRequest) error {
+		return check(logicalName, "context", req.ContextType.GetName(), req.ContextType.GetProperties(), func() (int64, map[string]proto.PropertyType, error) {
+			resp, err := client.GetContextType(ctx, &proto.GetContextTypeRequest{TypeName: req.ContextType.Name})
+			return resp.GetContextType().GetId(), resp.GetContextType().GetProperties(), err
+		})
+	}
+	checkArtifactType := func(logicalName string, req *proto.PutArtifactTypeRequest) error {
+		return check(logicalName, "artifact", req.ArtifactType.GetName(), req.ArtifactType.GetProperties(), func() (int64, map[string]proto.PropertyType, error) {
+			resp, err := client.GetArtifactType(ctx, &proto.GetArtifactTypeRequest{TypeName: req.ArtifactType.Name})
+			return resp.GetArtifactType().GetId(), resp.GetArtifactType().GetProperties(), err
+		})
+	}
+	checkExecutionType := func(logicalName string, req *proto.PutExecutionTypeRequest) error {
+		return check(logicalName, "execution", req.ExecutionType.GetName(), req.ExecutionType.GetProperties(), func() (int64, map[string]proto.PropertyType, error) {
+			resp, err := client.GetExecutionType(ctx, &proto.GetExecutionTypeRequest{TypeName: req.ExecutionType.Name})
+			return resp.GetExecutionType().GetId(), resp.GetExecutionType().GetProperties(), err
+		})
+	}
+
+	for _, err := range []error{
+		checkContextType(defaults.RegisteredModelTypeName, reqs.registeredModel),
+		checkContextType(defaults.ModelVersionTypeName, reqs.modelVersion),
+		checkArtifactType(defaults.DocArtifactTypeName, reqs.docArtifact),
+		checkArtifactType(defaults.ModelArtifactTypeName, reqs.modelArtifact),
+		checkContextType(defaults.ServingEnvironmentTypeName, reqs.servingEnvironment),
+		checkContextType(defaults.InferenceServiceTypeName, reqs.inferenceService),
+		checkExecutionType(defaults.ServeModelTypeName, reqs.serveModel),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("MLMD types do not match the Model Registry ones:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return typesMap, nil
+}
+
+// propertyProblems lists the expected properties missing from, or having a different type in, the actual ones
+func propertyProblems(kind, typeName string, expected, actual map[string]proto.PropertyType) []string {
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	problems := []string{}
+	for _, name := range names {
+		actualType, ok := actual[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s type %s is missing property %s of type %s", kind, typeName, name, expected[name]))
+		} else if actualType != expected[name] {
+			problems = append(problems, fmt.Sprintf("%s type %s has property %s of type %s, expected %s", kind, typeName, name, actualType, expected[name]))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,32 @@
+package mlmdtypes
+
+import (
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyProblems(t *testing.T) {
+	assertion := assert.New(t)
+
+	problems := propertyProblems("context", "kf.RegisteredModel", map[string]proto.PropertyType{
+		"description": proto.PropertyType_STRING,
+		"owner":       proto.PropertyType_STRING,
+		"state":       proto.PropertyType_STRING,
+	}, map[string]proto.PropertyType{
+		"description": proto.PropertyType_STRING,
+		"state":       proto.PropertyType_INT,
+		"extra":       proto.PropertyType_DOUBLE,
+	})
+
+	assertion.Equal([]string{
+		"context type kf.RegisteredModel is missing property owner of type STRING",
+		"context type kf.RegisteredModel has property state of type INT, expected STRING",
+	}, problems)
+}
+
+func TestPropertyProblemsNone(t *testing.T) {
+	expected := newTypeRequests(NewMLMDTypeNamesConfigFromDefaults()).serveModel.ExecutionType.GetProperties()
+	assert.Empty(t, propertyProblems("execution", "kf.ServeModel", expected, expected))
+}
@@ -0,0 +1,166 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// filesystemStore stores objects as files under a root directory
+type filesystemStore struct {
+	root      string
+	uriPrefix string
+}
+
+func newFilesystemStore(cfg Config) (*filesystemStore, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("missing root directory of the filesystem storage")
+	}
+	root, err := filepath.Abs(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root directory of the filesystem storage: %w", err)
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating root directory of the filesystem storage: %w", err)
+	}
+	uriPrefix := strings.TrimSuffix(cfg.URIPrefix, "/")
+	if uriPrefix == "" {
+		uriPrefix = "file://" + filepath.ToSlash(root)
+	}
+	return &filesystemStore{root: root, uriPrefix: uriPrefix}, nil
+}
+
+// Put writes the content to a temporary file renamed once complete, so that partial uploads are never visible
+func (s *filesystemStore) Put(ctx context.Context, key string, r io.Reader) error {
+	if !ValidKey(key) {
+		return fmt.Errorf("invalid object key %s", key)
+	}
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := io.Copy(file, &contextReader{ctx: ctx, r: r}); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (s *filesystemStore) Get(ctx context.Context, key string) (*Object, error) {
+	if !ValidKey(key) {
+		return nil, fmt.Errorf("invalid object key %s", key)
+	}
+	file, err := os.Open(filepath.Join(s.root, filepath.FromSlash(key)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotExist, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		file.Close()
+		return nil, fmt.Errorf("%w: %s is not a file", ErrNotExist, key)
+	}
+	return &Object{ReadCloser: &contextReadCloser{contextReader: contextReader{ctx: ctx, r: file}, file: file}, Size: info.Size()}, nil
+}
+
+// List walks the directory key, skipping symbolic links, which could point outside of the root directory
+func (s *filesystemStore) List(ctx context.Context, key string) ([]string, error) {
+	if !ValidKey(key) {
+		return nil, fmt.Errorf("invalid object key %s", key)
+	}
+	keys := []string{}
+	dir := filepath.Join(s.root, filepath.FromSlash(key))
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// dir itself is only listed when key is a file, not a directory
+		if path == dir || !entry.Type().IsRegular() {
+			return nil
+		}
+		relative, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key+"/"+filepath.ToSlash(relative))
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *filesystemStore) Delete(ctx context.Context, key string) error {
+	if !ValidKey(key) {
+		return fmt.Errorf("invalid object key %s", key)
+	}
+	return os.RemoveAll(filepath.Join(s.root, filepath.FromSlash(key)))
+}
+
+func (s *filesystemStore) URI(key string) string {
+	return s.uriPrefix + "/" + key
+}
+
+// Path returns key, the path relative to the root directory
+func (s *filesystemStore) Path(key string) string {
+	return key
+}
+
+func (s *filesystemStore) Key(uri string) (string, bool) {
+	return keyIn(uri, s.uriPrefix)
+}
+
+// contextReader stops reading once ctx is done, e.g. when the client uploading the content went away
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// contextReadCloser is a contextReader closing the file it reads
+type contextReadCloser struct {
+	contextReader
+	file *os.File
+}
+
+func (c *contextReadCloser) Close() error {
+	return c.file.Close()
+}
@@ -0,0 +1,129 @@
+// Package objectstore stores the content of model artifacts uploaded through the registry, so that users can
+// register models without credentials of the storage backend, on a filesystem or on S3 compatible object storage.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// Storage backend types
+const (
+	TypeNone       = ""
+	TypeFilesystem = "filesystem"
+	TypeS3         = "s3"
+)
+
+// ErrNotExist is returned when getting an object which doesn't exist
+var ErrNotExist = errors.New("object does not exist")
+
+// Store stores objects by key, keys being slash separated paths
+type Store interface {
+	// Put stores the content read from r under key, replacing the existing object, if any
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get opens the object stored under key, failing with ErrNotExist when there is none, e.g. when key is a directory
+	Get(ctx context.Context, key string) (*Object, error)
+	// List returns the sorted keys of all the objects stored in the directory key, including its subdirectories
+	List(ctx context.Context, key string) ([]string, error)
+	// Delete removes all the objects stored in the directory key, including its subdirectories
+	Delete(ctx context.Context, key string) error
+	// URI returns the URI model servers download the object, or the directory of objects, stored under key from
+	URI(key string) string
+	// Path returns the path of the object, or the directory of objects, stored under key within the storage backend,
+	// e.g. the S3 object key, recorded as storagePath of model artifacts
+	Path(key string) string
+	// Key returns the key of the object, or the directory of objects, at uri, false when uri is not in the store
+	Key(uri string) (string, bool)
+}
+
+// Object is the content of a stored object, to be closed once read
+type Object struct {
+	io.ReadCloser
+	// Size is the length of the content in bytes
+	Size int64
+}
+
+// Presigner is implemented by stores serving objects at URLs which don't require credentials for a limited time
+type Presigner interface {
+	// Presign returns the URL of the object stored under key, valid for the duration expires
+	Presign(key string, expires time.Duration) (string, error)
+}
+
+// Config configures the storage backend of uploaded model artifacts
+type Config struct {
+	// Type is the storage backend type, one of filesystem or s3, uploads are disabled when empty
+	Type string
+	// Path is the root directory of the filesystem backend
+	Path string
+	// URIPrefix replaces file:// followed by Path in the URIs of the filesystem backend, e.g. pvc://models when
+	// Path is where the models persistent volume claim is mounted
+	URIPrefix string
+	// Endpoint is the URL of the S3 compatible service, e.g. http://minio:9000, AWS S3 when empty
+	Endpoint string
+	// Region is the S3 region, used to sign requests
+	Region string
+	// Bucket is the S3 bucket objects are stored in
+	Bucket string
+	// Prefix is prepended to the keys of S3 objects
+	Prefix string
+	// PathStyle addresses buckets in the URL path rather than in the host name, as required by MinIO
+	PathStyle bool
+	// AccessKeyID and SecretAccessKey are the S3 credentials, read from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+	// when empty
+	AccessKeyID     string
+	SecretAccessKey string
+	// StorageKey is the name of the storage secret recorded on uploaded model artifacts, used by model servers to
+	// download them
+	StorageKey string
+	// AllowedPrefixes lists the directories, relative to Prefix or Path, of the content served for the model artifacts
+	// referencing StorageKey, when configured as a storage credential. No content is served when empty.
+	AllowedPrefixes []string
+}
+
+// New creates the store configured by cfg, nil when cfg.Type is empty
+func New(cfg Config) (Store, error) {
+	switch cfg.Type {
+	case TypeNone:
+		return nil, nil
+	case TypeFilesystem:
+		return newFilesystemStore(cfg)
+	case TypeS3:
+		if cfg.AccessKeyID == "" {
+			cfg.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		}
+		if cfg.SecretAccessKey == "" {
+			cfg.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		}
+		return newS3Store(cfg)
+	}
+	return nil, fmt.Errorf("invalid storage type %s, expected one of %s or %s", cfg.Type, TypeFilesystem, TypeS3)
+}
+
+// keyIn returns the key of the object, or directory of objects, at uri when it starts with prefix followed by /
+func keyIn(uri string, prefix string) (string, bool) {
+	key, ok := strings.CutPrefix(uri, prefix+"/")
+	if !ok || !ValidKey(key) {
+		return "", false
+	}
+	return key, true
+}
+
+// ValidKey returns whether key is a relative slash separated path, without empty, . or .. elements, which can
+// safely be used as object key and file path
+func ValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key {
+		return false
+	}
+	for _, element := range strings.Split(key, "/") {
+		if element == "." || element == ".." {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,473 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultRegion is the S3 region used when none is configured, as expected by MinIO
+	defaultRegion = "us-east-1"
+	// defaultPartSize is the size of the parts of multipart uploads, objects up to this size are put at once.
+	// It bounds the memory used by each upload, and the object size to 10000 parts.
+	defaultPartSize = 16 << 20
+	// amzDateFormat is the format of the x-amz-date header
+	amzDateFormat = "20060102T150405Z"
+	// maxPresignExpiry is the longest validity of presigned URLs allowed by signature version 4
+	maxPresignExpiry = 7 * 24 * time.Hour
+)
+
+// s3Store stores objects in a bucket of an S3 compatible service, with requests signed with AWS signature version 4
+type s3Store struct {
+	client          *http.Client
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	prefix          string
+	pathStyle       bool
+	accessKeyID     string
+	secretAccessKey string
+	partSize        int
+	now             func() time.Time
+}
+
+func newS3Store(cfg Config) (*s3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("missing S3 bucket")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("missing S3 credentials")
+	}
+	region := cfg.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid S3 endpoint %s, expected an http or https URL", endpoint)
+	}
+	return &s3Store{
+		client:          http.DefaultClient,
+		endpoint:        u,
+		region:          region,
+		bucket:          cfg.Bucket,
+		prefix:          strings.Trim(cfg.Prefix, "/"),
+		pathStyle:       cfg.PathStyle,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		partSize:        defaultPartSize,
+		now:             time.Now,
+	}, nil
+}
+
+// Put uploads content at most partSize long with a single request, longer content with a multipart upload
+func (s *s3Store) Put(ctx context.Context, key string, r io.Reader) error {
+	if !ValidKey(key) {
+		return fmt.Errorf("invalid object key %s", key)
+	}
+	part, err := readPart(r, s.partSize)
+	if err != nil {
+		return err
+	}
+	if len(part) < s.partSize {
+		_, err := s.do(ctx, http.MethodPut, key, nil, part)
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return err
+	}
+	initiated := struct {
+		UploadId string
+	}{}
+	if err := xml.Unmarshal(resp, &initiated); err != nil || initiated.UploadId == "" {
+		return fmt.Errorf("invalid S3 multipart upload response: %s", resp)
+	}
+	if err := s.putParts(ctx, key, initiated.UploadId, part, r); err != nil {
+		// abort with a fresh context, ctx may be the reason of the failure
+		if _, abortErr := s.do(context.Background(), http.MethodDelete, key, url.Values{"uploadId": {initiated.UploadId}}, nil); abortErr != nil {
+			return fmt.Errorf("%w, aborting the multipart upload failed as well: %v", err, abortErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// completedPart is a part of a multipart upload, as listed when completing it
+type completedPart struct {
+	PartNumber int
+	ETag       string
+}
+
+// putParts uploads first and the rest of r as parts of the multipart upload uploadId, then completes it
+func (s *s3Store) putParts(ctx context.Context, key string, uploadId string, first []byte, r io.Reader) error {
+	parts := []completedPart{}
+	for part := first; len(part) > 0; {
+		number := len(parts) + 1
+		query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {uploadId}}
+		etag, err := s.doForETag(ctx, key, query, part)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, completedPart{PartNumber: number, ETag: etag})
+		if len(part) < s.partSize {
+			break
+		}
+		if part, err = readPart(r, s.partSize); err != nil {
+			return err
+		}
+	}
+
+	complete, err := xml.Marshal(struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{Parts: parts})
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadId}}, complete)
+	if err != nil {
+		return err
+	}
+	// completing can fail after a 200 response, reported in the body
+	return s3ErrorFrom(resp)
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (*Object, error) {
+	if !ValidKey(key) {
+		return nil, fmt.Errorf("invalid object key %s", key)
+	}
+	resp, err := s.send(ctx, http.MethodGet, s.objectURL(key, nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Object{ReadCloser: resp.Body, Size: resp.ContentLength}, nil
+}
+
+// List lists the objects prefixed by the directory key, with as many ListObjectsV2 requests as pages of objects
+func (s *s3Store) List(ctx context.Context, key string) ([]string, error) {
+	if !ValidKey(key) {
+		return nil, fmt.Errorf("invalid object key %s", key)
+	}
+	prefix := s.objectKey(key) + "/"
+	keys := []string{}
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	for {
+		resp, err := s.send(ctx, http.MethodGet, s.bucketURL(query), nil)
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		page := struct {
+			Contents []struct {
+				Key string
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}{}
+		if err := xml.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("invalid S3 list objects response: %w", err)
+		}
+		for _, object := range page.Contents {
+			// directory markers created by some clients end with a slash
+			if object.Key != prefix && !strings.HasSuffix(object.Key, "/") {
+				keys = append(keys, key+"/"+strings.TrimPrefix(object.Key, prefix))
+			}
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			break
+		}
+		query.Set("continuation-token", page.NextContinuationToken)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Delete lists the objects of the directory key and deletes them one by one, S3 having no directories to remove
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	keys, err := s.List(ctx, key)
+	if err != nil {
+		return err
+	}
+	for _, objectKey := range keys {
+		if _, err := s.do(ctx, http.MethodDelete, objectKey, nil, nil); err != nil && !errors.Is(err, ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *s3Store) URI(key string) string {
+	return fmt.Sprintf("s3://%s/%s", s.bucket, s.objectKey(key))
+}
+
+func (s *s3Store) Path(key string) string {
+	return s.objectKey(key)
+}
+
+func (s *s3Store) Key(uri string) (string, bool) {
+	prefix := "s3://" + s.bucket
+	if s.prefix != "" {
+		prefix += "/" + s.prefix
+	}
+	return keyIn(uri, prefix)
+}
+
+// Presign returns a URL of the object signed in its query string, as described by the AWS signature version 4 docs
+func (s *s3Store) Presign(key string, expires time.Duration) (string, error) {
+	if !ValidKey(key) {
+		return "", fmt.Errorf("invalid object key %s", key)
+	}
+	if expires < time.Second || expires > maxPresignExpiry {
+		return "", fmt.Errorf("invalid expiry %s of presigned URL, expected between 1s and %s", expires, maxPresignExpiry)
+	}
+	now := s.now().UTC()
+	query := url.Values{
+		"X-Amz-Algorithm":     {"AWS4-HMAC-SHA256"},
+		"X-Amz-Credential":    {s.accessKeyID + "/" + s.scope(now)},
+		"X-Amz-Date":          {now.Format(amzDateFormat)},
+		"X-Amz-Expires":       {strconv.Itoa(int(expires.Seconds()))},
+		"X-Amz-SignedHeaders": {"host"},
+	}
+	u := s.objectURL(key, query)
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		u.RawQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	query.Set("X-Amz-Signature", s.signature(now, canonicalRequest))
+	u.RawQuery = canonicalQuery(query)
+	return u.String(), nil
+}
+
+// objectKey returns the key of the object stored under key, i.e. prefixed by the configured prefix
+func (s *s3Store) objectKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return s.prefix + "/" + key
+}
+
+// objectURL returns the URL of the object stored under key
+func (s *s3Store) objectURL(key string, query url.Values) *url.URL {
+	u := *s.endpoint
+	objectPath := strings.TrimSuffix(u.Path, "/") + "/" + s.objectKey(key)
+	if s.pathStyle {
+		objectPath = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + s.objectKey(key)
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = objectPath
+	u.RawPath = uriEncode(objectPath, false)
+	u.RawQuery = canonicalQuery(query)
+	return &u
+}
+
+// bucketURL returns the URL of the bucket, used to list its objects
+func (s *s3Store) bucketURL(query url.Values) *url.URL {
+	u := *s.endpoint
+	bucketPath := strings.TrimSuffix(u.Path, "/") + "/"
+	if s.pathStyle {
+		bucketPath += s.bucket + "/"
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = bucketPath
+	u.RawPath = uriEncode(bucketPath, false)
+	u.RawQuery = canonicalQuery(query)
+	return &u
+}
+
+// do sends a signed request for the object stored under key, returning the response body
+func (s *s3Store) do(ctx context.Context, method string, key string, query url.Values, body []byte) ([]byte, error) {
+	resp, err := s.send(ctx, method, s.objectURL(key, query), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// doForETag puts a part of a multipart upload, returning its ETag
+func (s *s3Store) doForETag(ctx context.Context, key string, query url.Values, body []byte) (string, error) {
+	resp, err := s.send(ctx, http.MethodPut, s.objectURL(key, query), body)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		return "", fmt.Errorf("missing ETag in S3 upload part response")
+	}
+	return etag, nil
+}
+
+// send sends a signed request, failing on error responses, with ErrNotExist when not found
+func (s *s3Store) send(ctx context.Context, method string, u *url.URL, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(body)
+	s.sign(req, hex.EncodeToString(sum[:]))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: S3 %s %s failed with %s", ErrNotExist, method, u.Path, resp.Status)
+		}
+		if err := s3ErrorFrom(data); err != nil {
+			return nil, fmt.Errorf("S3 %s %s failed with %s: %w", method, u.Path, resp.Status, err)
+		}
+		return nil, fmt.Errorf("S3 %s %s failed with %s", method, u.Path, resp.Status)
+	}
+	return resp, nil
+}
+
+// s3ErrorFrom returns the error reported in an S3 response body, if any
+func s3ErrorFrom(body []byte) error {
+	s3Error := struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{}
+	if err := xml.Unmarshal(body, &s3Error); err != nil || s3Error.Code == "" {
+		return nil
+	}
+	return fmt.Errorf("%s: %s", s3Error.Code, s3Error.Message)
+}
+
+// sign adds the AWS signature version 4 authorization header to req, signing its host and headers set so far
+func (s *s3Store) sign(req *http.Request, payloadHash string) {
+	now := s.now().UTC()
+	req.Header.Set("x-amz-date", now.Format(amzDateFormat))
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", s.accessKeyID, s.scope(now), signedHeaders, s.signature(now, canonicalRequest)))
+}
+
+// scope returns the credential scope of signatures made at t
+func (s *s3Store) scope(t time.Time) string {
+	return t.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+}
+
+// signature returns the hex encoded signature version 4 of canonicalRequest made at t
+func (s *s3Store) signature(t time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", t.Format(amzDateFormat), s.scope(t), hashHex(canonicalRequest)}, "\n")
+	return hex.EncodeToString(hmacSHA256(s.signingKey(t), stringToSign))
+}
+
+// signingKey derives the signature version 4 key of the day of t
+func (s *s3Store) signingKey(t time.Time) []byte {
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), t.Format("20060102"))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hashHex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalQuery encodes query sorted by key, as signed by signature version 4
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := []string{}
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes all the bytes of s but unreserved characters, and slashes unless encodeSlash is set
+func uriEncode(s string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for _, b := range []byte(s) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9', b == '-', b == '_', b == '.', b == '~':
+			encoded.WriteByte(b)
+		case b == '/' && !encodeSlash:
+			encoded.WriteByte(b)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return encoded.String()
+}
+
+// readPart reads up to size bytes from r, less only when r is exhausted
+func readPart(r io.Reader, size int) ([]byte, error) {
+	part := make([]byte, size)
+	n, err := io.ReadFull(r, part)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return part[:n], nil
+	}
+	return part[:n], err
+}
@@ -11,6 +11,7 @@ package openapi
 
 import (
 	"context"
+	"mime/multipart"
 	"net/http"
 
 	model "github.com/kubeflow/model-registry/pkg/openapi"
@@ -24,11 +25,13 @@ type ModelRegistryServiceAPIRouter interface {
 	CreateInferenceService(http.ResponseWriter, *http.Request)
 	CreateInferenceServiceServe(http.ResponseWriter, *http.Request)
 	CreateModelArtifact(http.ResponseWriter, *http.Request)
+	CreateModelArtifactSignature(http.ResponseWriter, *http.Request)
 	CreateModelVersion(http.ResponseWriter, *http.Request)
 	CreateModelVersionArtifact(http.ResponseWriter, *http.Request)
 	CreateRegisteredModel(http.ResponseWriter, *http.Request)
 	CreateRegisteredModelVersion(http.ResponseWriter, *http.Request)
 	CreateServingEnvironment(http.ResponseWriter, *http.Request)
+	DeleteModelArtifactSignature(http.ResponseWriter, *http.Request)
 	FindInferenceService(http.ResponseWriter, *http.Request)
 	FindModelArtifact(http.ResponseWriter, *http.Request)
 	FindModelVersion(http.ResponseWriter, *http.Request)
@@ -41,20 +44,32 @@ type ModelRegistryServiceAPIRouter interface {
 	GetInferenceServiceVersion(http.ResponseWriter, *http.Request)
 	GetInferenceServices(http.ResponseWriter, *http.Request)
 	GetModelArtifact(http.ResponseWriter, *http.Request)
+	GetModelArtifactContent(http.ResponseWriter, *http.Request)
+	GetModelArtifactInspection(http.ResponseWriter, *http.Request)
+	GetModelArtifactSignatures(http.ResponseWriter, *http.Request)
 	GetModelArtifacts(http.ResponseWriter, *http.Request)
 	GetModelVersion(http.ResponseWriter, *http.Request)
 	GetModelVersionArtifacts(http.ResponseWriter, *http.Request)
+	GetModelVersionCard(http.ResponseWriter, *http.Request)
+	GetModelVersionSignatureCompatibility(http.ResponseWriter, *http.Request)
 	GetModelVersions(http.ResponseWriter, *http.Request)
+	GetPropertySchema(http.ResponseWriter, *http.Request)
 	GetRegisteredModel(http.ResponseWriter, *http.Request)
+	GetRegisteredModelCard(http.ResponseWriter, *http.Request)
+	GetRegisteredModelPropertySchema(http.ResponseWriter, *http.Request)
 	GetRegisteredModelVersions(http.ResponseWriter, *http.Request)
 	GetRegisteredModels(http.ResponseWriter, *http.Request)
 	GetServingEnvironment(http.ResponseWriter, *http.Request)
 	GetServingEnvironments(http.ResponseWriter, *http.Request)
+	PresignModelArtifact(http.ResponseWriter, *http.Request)
 	UpdateInferenceService(http.ResponseWriter, *http.Request)
+	UpdateInferenceServiceServe(http.ResponseWriter, *http.Request)
 	UpdateModelArtifact(http.ResponseWriter, *http.Request)
 	UpdateModelVersion(http.ResponseWriter, *http.Request)
 	UpdateRegisteredModel(http.ResponseWriter, *http.Request)
+	UpdateRegisteredModelPropertySchema(http.ResponseWriter, *http.Request)
 	UpdateServingEnvironment(http.ResponseWriter, *http.Request)
+	UploadModelArtifact(http.ResponseWriter, *http.Request)
 }
 
 // ModelRegistryServiceAPIServicer defines the api actions for the ModelRegistryServiceAPI service
@@ -65,12 +80,14 @@ type ModelRegistryServiceAPIServicer interface {
 	CreateEnvironmentInferenceService(context.Context, string, model.InferenceServiceCreate) (ImplResponse, error)
 	CreateInferenceService(context.Context, model.InferenceServiceCreate) (ImplResponse, error)
 	CreateInferenceServiceServe(context.Context, string, model.ServeModelCreate) (ImplResponse, error)
-	CreateModelArtifact(context.Context, model.ModelArtifactCreate) (ImplResponse, error)
+	CreateModelArtifact(context.Context, model.ModelArtifactCreate, bool) (ImplResponse, error)
+	CreateModelArtifactSignature(context.Context, string, model.ArtifactSignatureCreate) (ImplResponse, error)
 	CreateModelVersion(context.Context, model.ModelVersionCreate) (ImplResponse, error)
-	CreateModelVersionArtifact(context.Context, string, model.Artifact) (ImplResponse, error)
+	CreateModelVersionArtifact(context.Context, string, model.Artifact, bool) (ImplResponse, error)
 	CreateRegisteredModel(context.Context, model.RegisteredModelCreate) (ImplResponse, error)
 	CreateRegisteredModelVersion(context.Context, string, model.ModelVersion) (ImplResponse, error)
 	CreateServingEnvironment(context.Context, model.ServingEnvironmentCreate) (ImplResponse, error)
+	DeleteModelArtifactSignature(context.Context, string, string) (ImplResponse, error)
 	FindInferenceService(context.Context, string, string, string) (ImplResponse, error)
 	FindModelArtifact(context.Context, string, string, string) (ImplResponse, error)
 	FindModelVersion(context.Context, string, string, string) (ImplResponse, error)
@@ -83,18 +100,36 @@ type ModelRegistryServiceAPIServicer interface {
 	GetInferenceServiceVersion(context.Context, string) (ImplResponse, error)
 	GetInferenceServices(context.Context, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	GetModelArtifact(context.Context, string) (ImplResponse, error)
+	GetModelArtifactContent(context.Context, string) (ImplResponse, error)
+	GetModelArtifactInspection(context.Context, string) (ImplResponse, error)
+	GetModelArtifactSignatures(context.Context, string) (ImplResponse, error)
 	GetModelArtifacts(context.Context, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	GetModelVersion(context.Context, string) (ImplResponse, error)
 	GetModelVersionArtifacts(context.Context, string, string, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
+	GetModelVersionCard(context.Context, string, string) (ImplResponse, error)
+	GetModelVersionSignatureCompatibility(context.Context, string, string) (ImplResponse, error)
 	GetModelVersions(context.Context, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
+	GetPropertySchema(context.Context, string) (ImplResponse, error)
 	GetRegisteredModel(context.Context, string) (ImplResponse, error)
+	GetRegisteredModelCard(context.Context, string, string) (ImplResponse, error)
+	GetRegisteredModelPropertySchema(context.Context, string, string) (ImplResponse, error)
 	GetRegisteredModelVersions(context.Context, string, string, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	GetRegisteredModels(context.Context, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	GetServingEnvironment(context.Context, string) (ImplResponse, error)
 	GetServingEnvironments(context.Context, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
+	PatchInferenceService(context.Context, string, Patch) (ImplResponse, error)
+	PatchInferenceServiceServe(context.Context, string, string, Patch) (ImplResponse, error)
+	PatchModelArtifact(context.Context, string, Patch, bool) (ImplResponse, error)
+	PatchModelVersion(context.Context, string, Patch) (ImplResponse, error)
+	PatchRegisteredModel(context.Context, string, Patch) (ImplResponse, error)
+	PatchServingEnvironment(context.Context, string, Patch) (ImplResponse, error)
+	PresignModelArtifact(context.Context, string, int32) (ImplResponse, error)
 	UpdateInferenceService(context.Context, string, model.InferenceServiceUpdate) (ImplResponse, error)
-	UpdateModelArtifact(context.Context, string, model.ModelArtifactUpdate) (ImplResponse, error)
+	UpdateInferenceServiceServe(context.Context, string, string, model.ServeModelUpdate) (ImplResponse, error)
+	UpdateModelArtifact(context.Context, string, model.ModelArtifactUpdate, bool) (ImplResponse, error)
 	UpdateModelVersion(context.Context, string, model.ModelVersionUpdate) (ImplResponse, error)
 	UpdateRegisteredModel(context.Context, string, model.RegisteredModelUpdate) (ImplResponse, error)
+	UpdateRegisteredModelPropertySchema(context.Context, string, string, model.PropertySchema) (ImplResponse, error)
 	UpdateServingEnvironment(context.Context, string, model.ServingEnvironmentUpdate) (ImplResponse, error)
+	UploadModelArtifact(context.Context, string, *multipart.Reader) (ImplResponse, error)
 }
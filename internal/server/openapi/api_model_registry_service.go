@@ -16,6 +16,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/kubeflow/model-registry/pkg/modelcard"
 	model "github.com/kubeflow/model-registry/pkg/openapi"
 )
 
@@ -72,6 +73,11 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/model_artifacts",
 			c.CreateModelArtifact,
 		},
+		"CreateModelArtifactSignature": Route{
+			strings.ToUpper("Post"),
+			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}/signatures",
+			c.CreateModelArtifactSignature,
+		},
 		"CreateModelVersion": Route{
 			strings.ToUpper("Post"),
 			"/api/model_registry/v1alpha3/model_versions",
@@ -97,6 +103,11 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/serving_environments",
 			c.CreateServingEnvironment,
 		},
+		"DeleteModelArtifactSignature": Route{
+			strings.ToUpper("Delete"),
+			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}/signatures/{keyId}",
+			c.DeleteModelArtifactSignature,
+		},
 		"FindInferenceService": Route{
 			strings.ToUpper("Get"),
 			"/api/model_registry/v1alpha3/inference_service",
@@ -157,6 +168,21 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}",
 			c.GetModelArtifact,
 		},
+		"GetModelArtifactContent": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}/content",
+			c.GetModelArtifactContent,
+		},
+		"GetModelArtifactInspection": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}/inspection",
+			c.GetModelArtifactInspection,
+		},
+		"GetModelArtifactSignatures": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}/signatures",
+			c.GetModelArtifactSignatures,
+		},
 		"GetModelArtifacts": Route{
 			strings.ToUpper("Get"),
 			"/api/model_registry/v1alpha3/model_artifacts",
@@ -172,16 +198,41 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/model_versions/{modelversionId}/artifacts",
 			c.GetModelVersionArtifacts,
 		},
+		"GetModelVersionCard": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/model_versions/{modelversionId}/card",
+			c.GetModelVersionCard,
+		},
+		"GetModelVersionSignatureCompatibility": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/model_versions/{modelversionId}/signature_compatibility",
+			c.GetModelVersionSignatureCompatibility,
+		},
 		"GetModelVersions": Route{
 			strings.ToUpper("Get"),
 			"/api/model_registry/v1alpha3/model_versions",
 			c.GetModelVersions,
 		},
+		"GetPropertySchema": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/property_schemas/{entityType}",
+			c.GetPropertySchema,
+		},
 		"GetRegisteredModel": Route{
 			strings.ToUpper("Get"),
 			"/api/model_registry/v1alpha3/registered_models/{registeredmodelId}",
 			c.GetRegisteredModel,
 		},
+		"GetRegisteredModelCard": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/registered_models/{registeredmodelId}/card",
+			c.GetRegisteredModelCard,
+		},
+		"GetRegisteredModelPropertySchema": Route{
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/registered_models/{registeredmodelId}/property_schemas/{entityType}",
+			c.GetRegisteredModelPropertySchema,
+		},
 		"GetRegisteredModelVersions": Route{
 			strings.ToUpper("Get"),
 			"/api/model_registry/v1alpha3/registered_models/{registeredmodelId}/versions",
@@ -202,11 +253,21 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/serving_environments",
 			c.GetServingEnvironments,
 		},
+		"PresignModelArtifact": Route{
+			strings.ToUpper("Post"),
+			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}/presign",
+			c.PresignModelArtifact,
+		},
 		"UpdateInferenceService": Route{
 			strings.ToUpper("Patch"),
 			"/api/model_registry/v1alpha3/inference_services/{inferenceserviceId}",
 			c.UpdateInferenceService,
 		},
+		"UpdateInferenceServiceServe": Route{
+			strings.ToUpper("Patch"),
+			"/api/model_registry/v1alpha3/inference_services/{inferenceserviceId}/serves/{servemodelId}",
+			c.UpdateInferenceServiceServe,
+		},
 		"UpdateModelArtifact": Route{
 			strings.ToUpper("Patch"),
 			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}",
@@ -222,11 +283,21 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/registered_models/{registeredmodelId}",
 			c.UpdateRegisteredModel,
 		},
+		"UpdateRegisteredModelPropertySchema": Route{
+			strings.ToUpper("Put"),
+			"/api/model_registry/v1alpha3/registered_models/{registeredmodelId}/property_schemas/{entityType}",
+			c.UpdateRegisteredModelPropertySchema,
+		},
 		"UpdateServingEnvironment": Route{
 			strings.ToUpper("Patch"),
 			"/api/model_registry/v1alpha3/serving_environments/{servingenvironmentId}",
 			c.UpdateServingEnvironment,
 		},
+		"UploadModelArtifact": Route{
+			strings.ToUpper("Post"),
+			"/api/model_registry/v1alpha3/model_artifacts/{modelartifactId}/upload",
+			c.UploadModelArtifact,
+		},
 	}
 }
 
@@ -315,6 +386,19 @@ func (c *ModelRegistryServiceAPIController) CreateInferenceServiceServe(w http.R
 
 // CreateModelArtifact - Create a ModelArtifact
 func (c *ModelRegistryServiceAPIController) CreateModelArtifact(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var inspectParam bool
+	if query.Has("inspect") {
+		param, err := parseBoolParameter(
+			query.Get("inspect"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			return
+		}
+		inspectParam = param
+	}
 	modelArtifactCreateParam := model.ModelArtifactCreate{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -330,7 +414,35 @@ func (c *ModelRegistryServiceAPIController) CreateModelArtifact(w http.ResponseW
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.CreateModelArtifact(r.Context(), modelArtifactCreateParam)
+	result, err := c.service.CreateModelArtifact(r.Context(), modelArtifactCreateParam, inspectParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CreateModelArtifactSignature - Sign a ModelArtifact
+func (c *ModelRegistryServiceAPIController) CreateModelArtifactSignature(w http.ResponseWriter, r *http.Request) {
+	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	artifactSignatureCreateParam := model.ArtifactSignatureCreate{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&artifactSignatureCreateParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertArtifactSignatureCreateRequired(artifactSignatureCreateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertArtifactSignatureCreateConstraints(artifactSignatureCreateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateModelArtifactSignature(r.Context(), modelartifactIdParam, artifactSignatureCreateParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -369,7 +481,20 @@ func (c *ModelRegistryServiceAPIController) CreateModelVersion(w http.ResponseWr
 
 // CreateModelVersionArtifact - Create an Artifact in a ModelVersion
 func (c *ModelRegistryServiceAPIController) CreateModelVersionArtifact(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
 	modelversionIdParam := chi.URLParam(r, "modelversionId")
+	var inspectParam bool
+	if query.Has("inspect") {
+		param, err := parseBoolParameter(
+			query.Get("inspect"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			return
+		}
+		inspectParam = param
+	}
 	artifactParam := model.Artifact{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -385,7 +510,7 @@ func (c *ModelRegistryServiceAPIController) CreateModelVersionArtifact(w http.Re
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.CreateModelVersionArtifact(r.Context(), modelversionIdParam, artifactParam)
+	result, err := c.service.CreateModelVersionArtifact(r.Context(), modelversionIdParam, artifactParam, inspectParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -477,6 +602,20 @@ func (c *ModelRegistryServiceAPIController) CreateServingEnvironment(w http.Resp
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// DeleteModelArtifactSignature - Remove a signature of a ModelArtifact
+func (c *ModelRegistryServiceAPIController) DeleteModelArtifactSignature(w http.ResponseWriter, r *http.Request) {
+	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	keyIdParam := chi.URLParam(r, "keyId")
+	result, err := c.service.DeleteModelArtifactSignature(r.Context(), modelartifactIdParam, keyIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // FindInferenceService - Get an InferenceServices that matches search parameters.
 func (c *ModelRegistryServiceAPIController) FindInferenceService(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
@@ -664,6 +803,45 @@ func (c *ModelRegistryServiceAPIController) GetModelArtifact(w http.ResponseWrit
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetModelArtifactContent - Download the content of a ModelArtifact
+func (c *ModelRegistryServiceAPIController) GetModelArtifactContent(w http.ResponseWriter, r *http.Request) {
+	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	result, err := c.service.GetModelArtifactContent(r.Context(), modelartifactIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeResponse(result.Body, &result.Code, w)
+}
+
+// GetModelArtifactInspection - Inspect the content of a ModelArtifact
+func (c *ModelRegistryServiceAPIController) GetModelArtifactInspection(w http.ResponseWriter, r *http.Request) {
+	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	result, err := c.service.GetModelArtifactInspection(r.Context(), modelartifactIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetModelArtifactSignatures - List All ModelArtifact's signatures
+func (c *ModelRegistryServiceAPIController) GetModelArtifactSignatures(w http.ResponseWriter, r *http.Request) {
+	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	result, err := c.service.GetModelArtifactSignatures(r.Context(), modelartifactIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetModelArtifacts - List All ModelArtifacts
 func (c *ModelRegistryServiceAPIController) GetModelArtifacts(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
@@ -714,6 +892,45 @@ func (c *ModelRegistryServiceAPIController) GetModelVersionArtifacts(w http.Resp
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetModelVersionCard - Render the model card of a ModelVersion
+func (c *ModelRegistryServiceAPIController) GetModelVersionCard(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	modelversionIdParam := chi.URLParam(r, "modelversionId")
+	formatParam := query.Get("format")
+	if formatParam == "" {
+		formatParam = string(modelcard.NegotiateFormat(r.Header.Get("Accept")))
+	}
+	result, err := c.service.GetModelVersionCard(r.Context(), modelversionIdParam, formatParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeResponse(result.Body, &result.Code, w)
+}
+
+// GetModelVersionSignatureCompatibility - Check the signature compatibility of a ModelVersion
+func (c *ModelRegistryServiceAPIController) GetModelVersionSignatureCompatibility(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	modelversionIdParam := chi.URLParam(r, "modelversionId")
+	var baseVersionIdParam string
+	if query.Has("baseVersionId") {
+		baseVersionIdParam = query.Get("baseVersionId")
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "baseVersionId"}, nil)
+		return
+	}
+	result, err := c.service.GetModelVersionSignatureCompatibility(r.Context(), modelversionIdParam, baseVersionIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetModelVersions - List All ModelVersions
 func (c *ModelRegistryServiceAPIController) GetModelVersions(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
@@ -731,6 +948,19 @@ func (c *ModelRegistryServiceAPIController) GetModelVersions(w http.ResponseWrit
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetPropertySchema - Get the global PropertySchema of an entity type
+func (c *ModelRegistryServiceAPIController) GetPropertySchema(w http.ResponseWriter, r *http.Request) {
+	entityTypeParam := chi.URLParam(r, "entityType")
+	result, err := c.service.GetPropertySchema(r.Context(), entityTypeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetRegisteredModel - Get a RegisteredModel
 func (c *ModelRegistryServiceAPIController) GetRegisteredModel(w http.ResponseWriter, r *http.Request) {
 	registeredmodelIdParam := chi.URLParam(r, "registeredmodelId")
@@ -744,6 +974,38 @@ func (c *ModelRegistryServiceAPIController) GetRegisteredModel(w http.ResponseWr
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetRegisteredModelCard - Render the model card of a RegisteredModel
+func (c *ModelRegistryServiceAPIController) GetRegisteredModelCard(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	registeredmodelIdParam := chi.URLParam(r, "registeredmodelId")
+	formatParam := query.Get("format")
+	if formatParam == "" {
+		formatParam = string(modelcard.NegotiateFormat(r.Header.Get("Accept")))
+	}
+	result, err := c.service.GetRegisteredModelCard(r.Context(), registeredmodelIdParam, formatParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeResponse(result.Body, &result.Code, w)
+}
+
+// GetRegisteredModelPropertySchema - Get the PropertySchema of an entity type in a RegisteredModel
+func (c *ModelRegistryServiceAPIController) GetRegisteredModelPropertySchema(w http.ResponseWriter, r *http.Request) {
+	registeredmodelIdParam := chi.URLParam(r, "registeredmodelId")
+	entityTypeParam := chi.URLParam(r, "entityType")
+	result, err := c.service.GetRegisteredModelPropertySchema(r.Context(), registeredmodelIdParam, entityTypeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetRegisteredModelVersions - List All RegisteredModel's ModelVersions
 func (c *ModelRegistryServiceAPIController) GetRegisteredModelVersions(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
@@ -811,9 +1073,45 @@ func (c *ModelRegistryServiceAPIController) GetServingEnvironments(w http.Respon
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// PresignModelArtifact - Get presigned URLs of the content of a ModelArtifact
+func (c *ModelRegistryServiceAPIController) PresignModelArtifact(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	var expiresInParam int32
+	if query.Has("expiresIn") {
+		param, err := parseNumericParameter[int32](
+			query.Get("expiresIn"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](604800),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			return
+		}
+		expiresInParam = param
+	} else {
+		var param int32 = 900
+		expiresInParam = param
+	}
+	result, err := c.service.PresignModelArtifact(r.Context(), modelartifactIdParam, expiresInParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // UpdateInferenceService - Update a InferenceService
 func (c *ModelRegistryServiceAPIController) UpdateInferenceService(w http.ResponseWriter, r *http.Request) {
 	inferenceserviceIdParam := chi.URLParam(r, "inferenceserviceId")
+	if c.handlePatch(w, r, func(patch Patch) (ImplResponse, error) {
+		return c.service.PatchInferenceService(r.Context(), inferenceserviceIdParam, patch)
+	}) {
+		return
+	}
 	inferenceServiceUpdateParam := model.InferenceServiceUpdate{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -839,9 +1137,61 @@ func (c *ModelRegistryServiceAPIController) UpdateInferenceService(w http.Respon
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// UpdateInferenceServiceServe - Update a ServeModel action of an InferenceService
+func (c *ModelRegistryServiceAPIController) UpdateInferenceServiceServe(w http.ResponseWriter, r *http.Request) {
+	inferenceserviceIdParam := chi.URLParam(r, "inferenceserviceId")
+	servemodelIdParam := chi.URLParam(r, "servemodelId")
+	if c.handlePatch(w, r, func(patch Patch) (ImplResponse, error) {
+		return c.service.PatchInferenceServiceServe(r.Context(), inferenceserviceIdParam, servemodelIdParam, patch)
+	}) {
+		return
+	}
+	serveModelUpdateParam := model.ServeModelUpdate{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&serveModelUpdateParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertServeModelUpdateRequired(serveModelUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertServeModelUpdateConstraints(serveModelUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateInferenceServiceServe(r.Context(), inferenceserviceIdParam, servemodelIdParam, serveModelUpdateParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // UpdateModelArtifact - Update a ModelArtifact
 func (c *ModelRegistryServiceAPIController) UpdateModelArtifact(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
 	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	var allowDigestChangeParam bool
+	if query.Has("allowDigestChange") {
+		param, err := parseBoolParameter(
+			query.Get("allowDigestChange"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			return
+		}
+		allowDigestChangeParam = param
+	}
+	if c.handlePatch(w, r, func(patch Patch) (ImplResponse, error) {
+		return c.service.PatchModelArtifact(r.Context(), modelartifactIdParam, patch, allowDigestChangeParam)
+	}) {
+		return
+	}
 	modelArtifactUpdateParam := model.ModelArtifactUpdate{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -857,7 +1207,7 @@ func (c *ModelRegistryServiceAPIController) UpdateModelArtifact(w http.ResponseW
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.UpdateModelArtifact(r.Context(), modelartifactIdParam, modelArtifactUpdateParam)
+	result, err := c.service.UpdateModelArtifact(r.Context(), modelartifactIdParam, modelArtifactUpdateParam, allowDigestChangeParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -870,6 +1220,11 @@ func (c *ModelRegistryServiceAPIController) UpdateModelArtifact(w http.ResponseW
 // UpdateModelVersion - Update a ModelVersion
 func (c *ModelRegistryServiceAPIController) UpdateModelVersion(w http.ResponseWriter, r *http.Request) {
 	modelversionIdParam := chi.URLParam(r, "modelversionId")
+	if c.handlePatch(w, r, func(patch Patch) (ImplResponse, error) {
+		return c.service.PatchModelVersion(r.Context(), modelversionIdParam, patch)
+	}) {
+		return
+	}
 	modelVersionUpdateParam := model.ModelVersionUpdate{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -898,6 +1253,11 @@ func (c *ModelRegistryServiceAPIController) UpdateModelVersion(w http.ResponseWr
 // UpdateRegisteredModel - Update a RegisteredModel
 func (c *ModelRegistryServiceAPIController) UpdateRegisteredModel(w http.ResponseWriter, r *http.Request) {
 	registeredmodelIdParam := chi.URLParam(r, "registeredmodelId")
+	if c.handlePatch(w, r, func(patch Patch) (ImplResponse, error) {
+		return c.service.PatchRegisteredModel(r.Context(), registeredmodelIdParam, patch)
+	}) {
+		return
+	}
 	registeredModelUpdateParam := model.RegisteredModelUpdate{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -923,9 +1283,43 @@ func (c *ModelRegistryServiceAPIController) UpdateRegisteredModel(w http.Respons
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// UpdateRegisteredModelPropertySchema - Attach a PropertySchema to a RegisteredModel
+func (c *ModelRegistryServiceAPIController) UpdateRegisteredModelPropertySchema(w http.ResponseWriter, r *http.Request) {
+	registeredmodelIdParam := chi.URLParam(r, "registeredmodelId")
+	entityTypeParam := chi.URLParam(r, "entityType")
+	propertySchemaParam := model.PropertySchema{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&propertySchemaParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertPropertySchemaRequired(propertySchemaParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertPropertySchemaConstraints(propertySchemaParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateRegisteredModelPropertySchema(r.Context(), registeredmodelIdParam, entityTypeParam, propertySchemaParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // UpdateServingEnvironment - Update a ServingEnvironment
 func (c *ModelRegistryServiceAPIController) UpdateServingEnvironment(w http.ResponseWriter, r *http.Request) {
 	servingenvironmentIdParam := chi.URLParam(r, "servingenvironmentId")
+	if c.handlePatch(w, r, func(patch Patch) (ImplResponse, error) {
+		return c.service.PatchServingEnvironment(r.Context(), servingenvironmentIdParam, patch)
+	}) {
+		return
+	}
 	servingEnvironmentUpdateParam := model.ServingEnvironmentUpdate{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -950,3 +1344,22 @@ func (c *ModelRegistryServiceAPIController) UpdateServingEnvironment(w http.Resp
 	// If no error, encode the body and the result code
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// UploadModelArtifact - Upload the content of a ModelArtifact
+func (c *ModelRegistryServiceAPIController) UploadModelArtifact(w http.ResponseWriter, r *http.Request) {
+	modelartifactIdParam := chi.URLParam(r, "modelartifactId")
+	// files are streamed to the storage backend part by part, rather than buffered by r.ParseMultipartForm
+	fileParam, err := r.MultipartReader()
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.UploadModelArtifact(r.Context(), modelartifactIdParam, fileParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
@@ -16,26 +16,50 @@ import (
 	"github.com/kubeflow/model-registry/internal/apiutils"
 	"github.com/kubeflow/model-registry/internal/converter"
 	"github.com/kubeflow/model-registry/internal/converter/generated"
+	"github.com/kubeflow/model-registry/internal/objectstore"
 	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/modelcard"
 	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/signature"
+	"github.com/kubeflow/model-registry/pkg/validation"
 )
 
 // ModelRegistryServiceAPIService is a service that implements the logic for the ModelRegistryServiceAPIServicer
-// This service should implement the business logic for every endpoint for the ModelRegistryServiceAPI s.coreApi.
+// This service should implement the business logic for every endpoint for the ModelRegistryServiceAPI coreApi.
 // Include any external packages or services that will be required by this service.
 type ModelRegistryServiceAPIService struct {
 	coreApi    api.ModelRegistryApi
 	converter  converter.OpenAPIConverter
 	reconciler converter.OpenAPIReconciler
+	modelCards *modelcard.Renderer
+	// objectStore stores uploaded model artifact files, uploads are disabled when nil
+	objectStore objectstore.Store
+	storageKey  string
+	// objectStores serve the content of model artifacts, by the storageKey they reference
+	objectStores map[string]objectstore.Store
+	// allowedPrefixes are the directories of the content served by the storage credentials, by storageKey
+	allowedPrefixes map[string][]string
+	// validator checks uploaded model artifacts before their files are stored, if set
+	validator *validation.Validator
 }
 
+// ServiceOption configures optional features of the api service
+type ServiceOption func(s *ModelRegistryServiceAPIService)
+
 // NewModelRegistryServiceAPIService creates a default api service
-func NewModelRegistryServiceAPIService(coreApi api.ModelRegistryApi) ModelRegistryServiceAPIServicer {
-	return &ModelRegistryServiceAPIService{
-		coreApi:    coreApi,
-		converter:  &generated.OpenAPIConverterImpl{},
-		reconciler: &generated.OpenAPIReconcilerImpl{},
-	}
+func NewModelRegistryServiceAPIService(coreApi api.ModelRegistryApi, opts ...ServiceOption) ModelRegistryServiceAPIServicer {
+	s := &ModelRegistryServiceAPIService{
+		coreApi:         coreApi,
+		converter:       &generated.OpenAPIConverterImpl{},
+		reconciler:      &generated.OpenAPIReconcilerImpl{},
+		modelCards:      modelcard.DefaultRenderer(),
+		objectStores:    map[string]objectstore.Store{},
+		allowedPrefixes: map[string][]string{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateEnvironmentInferenceService - Create a InferenceService in ServingEnvironment
@@ -47,15 +71,18 @@ func (s *ModelRegistryServiceAPIService) CreateEnvironmentInferenceService(ctx c
 
 // CreateInferenceService - Create a InferenceService
 func (s *ModelRegistryServiceAPIService) CreateInferenceService(ctx context.Context, inferenceServiceCreate model.InferenceServiceCreate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	entity, err := s.converter.ConvertInferenceServiceCreate(&inferenceServiceCreate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 
-	result, err := s.coreApi.UpsertInferenceService(entity)
+	result, err := coreApi.UpsertInferenceService(entity)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
@@ -63,58 +90,94 @@ func (s *ModelRegistryServiceAPIService) CreateInferenceService(ctx context.Cont
 
 // CreateInferenceServiceServe - Create a ServeModel action in a InferenceService
 func (s *ModelRegistryServiceAPIService) CreateInferenceServiceServe(ctx context.Context, inferenceserviceId string, serveModelCreate model.ServeModelCreate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	entity, err := s.converter.ConvertServeModelCreate(&serveModelCreate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 
-	result, err := s.coreApi.UpsertServeModel(entity, &inferenceserviceId)
+	result, err := coreApi.UpsertServeModel(entity, &inferenceserviceId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
 }
 
 // CreateModelArtifact - Create a ModelArtifact
-func (s *ModelRegistryServiceAPIService) CreateModelArtifact(ctx context.Context, modelArtifactCreate model.ModelArtifactCreate) (ImplResponse, error) {
+func (s *ModelRegistryServiceAPIService) CreateModelArtifact(ctx context.Context, modelArtifactCreate model.ModelArtifactCreate, inspect bool) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	entity, err := s.converter.ConvertModelArtifactCreate(&modelArtifactCreate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
+	}
+	if inspect {
+		if err := s.fillFromInspection(ctx, entity); err != nil {
+			return ErrorResponse(ctx, err), nil
+		}
 	}
 
-	result, err := s.coreApi.UpsertModelArtifact(entity, nil)
+	result, err := coreApi.UpsertModelArtifact(entity, nil)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// CreateModelArtifactSignature - Sign a ModelArtifact
+func (s *ModelRegistryServiceAPIService) CreateModelArtifactSignature(ctx context.Context, modelartifactId string, artifactSignatureCreate model.ArtifactSignatureCreate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.UpsertModelArtifactSignature(&artifactSignatureCreate, modelartifactId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusCreated, result), nil
+}
+
 // CreateModelVersion - Create a ModelVersion
 func (s *ModelRegistryServiceAPIService) CreateModelVersion(ctx context.Context, modelVersionCreate model.ModelVersionCreate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	modelVersion, err := s.converter.ConvertModelVersionCreate(&modelVersionCreate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 
-	result, err := s.coreApi.UpsertModelVersion(modelVersion, &modelVersionCreate.RegisteredModelId)
+	result, err := coreApi.UpsertModelVersion(modelVersion, &modelVersionCreate.RegisteredModelId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
 }
 
 // CreateModelVersionArtifact - Create an Artifact in a ModelVersion
-func (s *ModelRegistryServiceAPIService) CreateModelVersionArtifact(ctx context.Context, modelversionId string, artifact model.Artifact) (ImplResponse, error) {
-	result, err := s.coreApi.UpsertArtifact(&artifact, &modelversionId)
+func (s *ModelRegistryServiceAPIService) CreateModelVersionArtifact(ctx context.Context, modelversionId string, artifact model.Artifact, inspect bool) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	// only model artifacts have a model format
+	if inspect && artifact.ModelArtifact != nil {
+		if err := s.fillFromInspection(ctx, artifact.ModelArtifact); err != nil {
+			return ErrorResponse(ctx, err), nil
+		}
+	}
+	result, err := coreApi.UpsertArtifact(&artifact, &modelversionId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// return Response(http.StatusNotImplemented, nil), errors.New("unsupported artifactType")
@@ -124,15 +187,18 @@ func (s *ModelRegistryServiceAPIService) CreateModelVersionArtifact(ctx context.
 
 // CreateRegisteredModel - Create a RegisteredModel
 func (s *ModelRegistryServiceAPIService) CreateRegisteredModel(ctx context.Context, registeredModelCreate model.RegisteredModelCreate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	registeredModel, err := s.converter.ConvertRegisteredModelCreate(&registeredModelCreate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 
-	result, err := s.coreApi.UpsertRegisteredModel(registeredModel)
+	result, err := coreApi.UpsertRegisteredModel(registeredModel)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
@@ -140,10 +206,13 @@ func (s *ModelRegistryServiceAPIService) CreateRegisteredModel(ctx context.Conte
 
 // CreateRegisteredModelVersion - Create a ModelVersion in RegisteredModel
 func (s *ModelRegistryServiceAPIService) CreateRegisteredModelVersion(ctx context.Context, registeredmodelId string, modelVersion model.ModelVersion) (ImplResponse, error) {
-	result, err := s.coreApi.UpsertModelVersion(&modelVersion, apiutils.StrPtr(registeredmodelId))
+	coreApi, err := s.coreApiFor(ctx)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.UpsertModelVersion(&modelVersion, apiutils.StrPtr(registeredmodelId))
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -151,26 +220,44 @@ func (s *ModelRegistryServiceAPIService) CreateRegisteredModelVersion(ctx contex
 
 // CreateServingEnvironment - Create a ServingEnvironment
 func (s *ModelRegistryServiceAPIService) CreateServingEnvironment(ctx context.Context, servingEnvironmentCreate model.ServingEnvironmentCreate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	entity, err := s.converter.ConvertServingEnvironmentCreate(&servingEnvironmentCreate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 
-	result, err := s.coreApi.UpsertServingEnvironment(entity)
+	result, err := coreApi.UpsertServingEnvironment(entity)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusCreated, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// DeleteModelArtifactSignature - Remove a signature of a ModelArtifact
+func (s *ModelRegistryServiceAPIService) DeleteModelArtifactSignature(ctx context.Context, modelartifactId string, keyId string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	if err := coreApi.DeleteModelArtifactSignature(modelartifactId, keyId); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusNoContent, nil), nil
+}
+
 // FindInferenceService - Get an InferenceServices that matches search parameters.
 func (s *ModelRegistryServiceAPIService) FindInferenceService(ctx context.Context, name string, externalId string, parentResourceId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetInferenceServiceByParams(apiutils.StrPtr(name), apiutils.StrPtr(parentResourceId), apiutils.StrPtr(externalId))
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetInferenceServiceByParams(apiutils.StrPtr(name), apiutils.StrPtr(parentResourceId), apiutils.StrPtr(externalId))
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -178,10 +265,13 @@ func (s *ModelRegistryServiceAPIService) FindInferenceService(ctx context.Contex
 
 // FindModelArtifact - Get a ModelArtifact that matches search parameters.
 func (s *ModelRegistryServiceAPIService) FindModelArtifact(ctx context.Context, name string, externalId string, parentResourceId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetModelArtifactByParams(apiutils.StrPtr(name), apiutils.StrPtr(parentResourceId), apiutils.StrPtr(externalId))
+	coreApi, err := s.coreApiFor(ctx)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetModelArtifactByParams(apiutils.StrPtr(name), apiutils.StrPtr(parentResourceId), apiutils.StrPtr(externalId))
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -189,10 +279,13 @@ func (s *ModelRegistryServiceAPIService) FindModelArtifact(ctx context.Context,
 
 // FindModelVersion - Get a ModelVersion that matches search parameters.
 func (s *ModelRegistryServiceAPIService) FindModelVersion(ctx context.Context, name string, externalId string, registeredModelId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetModelVersionByParams(apiutils.StrPtr(name), apiutils.StrPtr(registeredModelId), apiutils.StrPtr(externalId))
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetModelVersionByParams(apiutils.StrPtr(name), apiutils.StrPtr(registeredModelId), apiutils.StrPtr(externalId))
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -200,10 +293,13 @@ func (s *ModelRegistryServiceAPIService) FindModelVersion(ctx context.Context, n
 
 // FindRegisteredModel - Get a RegisteredModel that matches search parameters.
 func (s *ModelRegistryServiceAPIService) FindRegisteredModel(ctx context.Context, name string, externalID string) (ImplResponse, error) {
-	result, err := s.coreApi.GetRegisteredModelByParams(apiutils.StrPtr(name), apiutils.StrPtr(externalID))
+	coreApi, err := s.coreApiFor(ctx)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetRegisteredModelByParams(apiutils.StrPtr(name), apiutils.StrPtr(externalID))
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -211,10 +307,13 @@ func (s *ModelRegistryServiceAPIService) FindRegisteredModel(ctx context.Context
 
 // FindServingEnvironment - Find ServingEnvironment
 func (s *ModelRegistryServiceAPIService) FindServingEnvironment(ctx context.Context, name string, externalID string) (ImplResponse, error) {
-	result, err := s.coreApi.GetServingEnvironmentByParams(apiutils.StrPtr(name), apiutils.StrPtr(externalID))
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetServingEnvironmentByParams(apiutils.StrPtr(name), apiutils.StrPtr(externalID))
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -222,15 +321,17 @@ func (s *ModelRegistryServiceAPIService) FindServingEnvironment(ctx context.Cont
 
 // GetEnvironmentInferenceServices - List All ServingEnvironment&#39;s InferenceServices
 func (s *ModelRegistryServiceAPIService) GetEnvironmentInferenceServices(ctx context.Context, servingenvironmentId string, name string, externalID string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetInferenceServices(listOpts, apiutils.StrPtr(servingenvironmentId), nil)
+	result, err := coreApi.GetInferenceServices(listOpts, apiutils.StrPtr(servingenvironmentId), nil)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -238,10 +339,13 @@ func (s *ModelRegistryServiceAPIService) GetEnvironmentInferenceServices(ctx con
 
 // GetInferenceService - Get a InferenceService
 func (s *ModelRegistryServiceAPIService) GetInferenceService(ctx context.Context, inferenceserviceId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetInferenceServiceById(inferenceserviceId)
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetInferenceServiceById(inferenceserviceId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
@@ -249,10 +353,13 @@ func (s *ModelRegistryServiceAPIService) GetInferenceService(ctx context.Context
 
 // GetInferenceServiceModel - Get InferenceService&#39;s RegisteredModel
 func (s *ModelRegistryServiceAPIService) GetInferenceServiceModel(ctx context.Context, inferenceserviceId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetRegisteredModelByInferenceService(inferenceserviceId)
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetRegisteredModelByInferenceService(inferenceserviceId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
@@ -260,15 +367,17 @@ func (s *ModelRegistryServiceAPIService) GetInferenceServiceModel(ctx context.Co
 
 // GetInferenceServiceServes - List All InferenceService&#39;s ServeModel actions
 func (s *ModelRegistryServiceAPIService) GetInferenceServiceServes(ctx context.Context, inferenceserviceId string, name string, externalID string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetServeModels(listOpts, apiutils.StrPtr(inferenceserviceId))
+	result, err := coreApi.GetServeModels(listOpts, apiutils.StrPtr(inferenceserviceId))
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -276,10 +385,13 @@ func (s *ModelRegistryServiceAPIService) GetInferenceServiceServes(ctx context.C
 
 // GetInferenceServiceVersion - Get InferenceService&#39;s ModelVersion
 func (s *ModelRegistryServiceAPIService) GetInferenceServiceVersion(ctx context.Context, inferenceserviceId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetModelVersionByInferenceService(inferenceserviceId)
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetModelVersionByInferenceService(inferenceserviceId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
@@ -287,15 +399,17 @@ func (s *ModelRegistryServiceAPIService) GetInferenceServiceVersion(ctx context.
 
 // GetInferenceServices - List All InferenceServices
 func (s *ModelRegistryServiceAPIService) GetInferenceServices(ctx context.Context, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetInferenceServices(listOpts, nil, nil)
+	result, err := coreApi.GetInferenceServices(listOpts, nil, nil)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -303,26 +417,44 @@ func (s *ModelRegistryServiceAPIService) GetInferenceServices(ctx context.Contex
 
 // GetModelArtifact - Get a ModelArtifact
 func (s *ModelRegistryServiceAPIService) GetModelArtifact(ctx context.Context, modelartifactId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetModelArtifactById(modelartifactId)
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetModelArtifactById(modelartifactId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// GetModelArtifactSignatures - List All ModelArtifact's signatures
+func (s *ModelRegistryServiceAPIService) GetModelArtifactSignatures(ctx context.Context, modelartifactId string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetModelArtifactSignatures(modelartifactId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // GetModelArtifacts - List All ModelArtifacts
 func (s *ModelRegistryServiceAPIService) GetModelArtifacts(ctx context.Context, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetModelArtifacts(listOpts, nil)
+	result, err := coreApi.GetModelArtifacts(listOpts, nil)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -330,10 +462,13 @@ func (s *ModelRegistryServiceAPIService) GetModelArtifacts(ctx context.Context,
 
 // GetModelVersion - Get a ModelVersion
 func (s *ModelRegistryServiceAPIService) GetModelVersion(ctx context.Context, modelversionId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetModelVersionById(modelversionId)
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetModelVersionById(modelversionId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
@@ -341,62 +476,136 @@ func (s *ModelRegistryServiceAPIService) GetModelVersion(ctx context.Context, mo
 
 // GetModelVersionArtifacts - List All ModelVersion&#39;s artifacts
 func (s *ModelRegistryServiceAPIService) GetModelVersionArtifacts(ctx context.Context, modelversionId string, name string, externalID string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	// TODO name unused
 	// TODO externalID unused
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetArtifacts(listOpts, apiutils.StrPtr(modelversionId))
+	result, err := coreApi.GetArtifacts(listOpts, apiutils.StrPtr(modelversionId))
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// GetModelVersionCard - Render the model card of a ModelVersion
+func (s *ModelRegistryServiceAPIService) GetModelVersionCard(ctx context.Context, modelversionId string, format string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	card, err := modelcard.BuildModelVersion(coreApi, modelversionId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return s.renderModelCard(ctx, card, format), nil
+}
+
+// GetModelVersionSignatureCompatibility - Check the signature compatibility of a ModelVersion
+func (s *ModelRegistryServiceAPIService) GetModelVersionSignatureCompatibility(ctx context.Context, modelversionId string, baseVersionId string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := signature.CheckModelVersions(coreApi, modelversionId, baseVersionId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // GetModelVersions - List All ModelVersions
 func (s *ModelRegistryServiceAPIService) GetModelVersions(ctx context.Context, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetModelVersions(listOpts, nil)
+	result, err := coreApi.GetModelVersions(listOpts, nil)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// GetPropertySchema - Get the global PropertySchema of an entity type
+func (s *ModelRegistryServiceAPIService) GetPropertySchema(ctx context.Context, entityType string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetPropertySchema(entityType, nil)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // GetRegisteredModel - Get a RegisteredModel
 func (s *ModelRegistryServiceAPIService) GetRegisteredModel(ctx context.Context, registeredmodelId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetRegisteredModelById(registeredmodelId)
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetRegisteredModelById(registeredmodelId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// GetRegisteredModelCard - Render the model card of a RegisteredModel
+func (s *ModelRegistryServiceAPIService) GetRegisteredModelCard(ctx context.Context, registeredmodelId string, format string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	card, err := modelcard.Build(coreApi, registeredmodelId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return s.renderModelCard(ctx, card, format), nil
+}
+
+// GetRegisteredModelPropertySchema - Get the PropertySchema of an entity type in a RegisteredModel
+func (s *ModelRegistryServiceAPIService) GetRegisteredModelPropertySchema(ctx context.Context, registeredmodelId string, entityType string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetPropertySchema(entityType, &registeredmodelId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // GetRegisteredModelVersions - List All RegisteredModel&#39;s ModelVersions
 func (s *ModelRegistryServiceAPIService) GetRegisteredModelVersions(ctx context.Context, registeredmodelId string, name string, externalID string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	// TODO name unused
 	// TODO externalID unused
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetModelVersions(listOpts, apiutils.StrPtr(registeredmodelId))
+	result, err := coreApi.GetModelVersions(listOpts, apiutils.StrPtr(registeredmodelId))
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -404,15 +613,17 @@ func (s *ModelRegistryServiceAPIService) GetRegisteredModelVersions(ctx context.
 
 // GetRegisteredModels - List All RegisteredModels
 func (s *ModelRegistryServiceAPIService) GetRegisteredModels(ctx context.Context, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetRegisteredModels(listOpts)
+	result, err := coreApi.GetRegisteredModels(listOpts)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -420,10 +631,13 @@ func (s *ModelRegistryServiceAPIService) GetRegisteredModels(ctx context.Context
 
 // GetServingEnvironment - Get a ServingEnvironment
 func (s *ModelRegistryServiceAPIService) GetServingEnvironment(ctx context.Context, servingenvironmentId string) (ImplResponse, error) {
-	result, err := s.coreApi.GetServingEnvironmentById(servingenvironmentId)
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	result, err := coreApi.GetServingEnvironmentById(servingenvironmentId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO: return Response(http.StatusUnauthorized, Error{}), nil
@@ -431,65 +645,295 @@ func (s *ModelRegistryServiceAPIService) GetServingEnvironment(ctx context.Conte
 
 // GetServingEnvironments - List All ServingEnvironments
 func (s *ModelRegistryServiceAPIService) GetServingEnvironments(ctx context.Context, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	listOpts, err := apiutils.BuildListOption(pageSize, orderBy, sortOrder, nextPageToken)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.GetServingEnvironments(listOpts)
+	result, err := coreApi.GetServingEnvironments(listOpts)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// PatchInferenceService - Patch a InferenceService with a JSON Merge Patch or a JSON Patch
+func (s *ModelRegistryServiceAPIService) PatchInferenceService(ctx context.Context, inferenceserviceId string, patch Patch) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	existing, err := coreApi.GetInferenceServiceById(inferenceserviceId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	inferenceServiceUpdate := model.InferenceServiceUpdate{}
+	if err := applyPatch(existing, patch, &inferenceServiceUpdate); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	if err := AssertInferenceServiceUpdateRequired(inferenceServiceUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	if err := AssertInferenceServiceUpdateConstraints(inferenceServiceUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	entity, err := s.converter.ConvertInferenceServiceUpdate(&inferenceServiceUpdate)
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	entity.Id = &inferenceserviceId
+	result, err := coreApi.UpsertInferenceService(entity)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
+// PatchInferenceServiceServe - Patch a ServeModel of an InferenceService with a JSON Merge Patch or a JSON Patch
+func (s *ModelRegistryServiceAPIService) PatchInferenceServiceServe(ctx context.Context, inferenceserviceId string, servemodelId string, patch Patch) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	existing, err := coreApi.GetServeModelById(servemodelId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	serveModelUpdate := model.ServeModelUpdate{}
+	if err := applyPatch(existing, patch, &serveModelUpdate); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	if err := AssertServeModelUpdateRequired(serveModelUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	if err := AssertServeModelUpdateConstraints(serveModelUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	entity, err := s.converter.ConvertServeModelUpdate(&serveModelUpdate)
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	entity.Id = &servemodelId
+	result, err := coreApi.UpsertServeModel(entity, &inferenceserviceId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
+// PatchModelArtifact - Patch a ModelArtifact with a JSON Merge Patch or a JSON Patch
+func (s *ModelRegistryServiceAPIService) PatchModelArtifact(ctx context.Context, modelartifactId string, patch Patch, allowDigestChange bool) (ImplResponse, error) {
+	if allowDigestChange {
+		ctx = api.WithDigestChangeAllowed(ctx)
+	}
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	existing, err := coreApi.GetModelArtifactById(modelartifactId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	modelArtifactUpdate := model.ModelArtifactUpdate{}
+	if err := applyPatch(existing, patch, &modelArtifactUpdate); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	if err := AssertModelArtifactUpdateRequired(modelArtifactUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	if err := AssertModelArtifactUpdateConstraints(modelArtifactUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	modelArtifact, err := s.converter.ConvertModelArtifactUpdate(&modelArtifactUpdate)
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	modelArtifact.Id = &modelartifactId
+	result, err := coreApi.UpsertModelArtifact(modelArtifact, nil)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
+// PatchModelVersion - Patch a ModelVersion with a JSON Merge Patch or a JSON Patch
+func (s *ModelRegistryServiceAPIService) PatchModelVersion(ctx context.Context, modelversionId string, patch Patch) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	existing, err := coreApi.GetModelVersionById(modelversionId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	modelVersionUpdate := model.ModelVersionUpdate{}
+	if err := applyPatch(existing, patch, &modelVersionUpdate); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	if err := AssertModelVersionUpdateRequired(modelVersionUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	if err := AssertModelVersionUpdateConstraints(modelVersionUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	modelVersion, err := s.converter.ConvertModelVersionUpdate(&modelVersionUpdate)
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	modelVersion.Id = &modelversionId
+	result, err := coreApi.UpsertModelVersion(modelVersion, nil)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
+// PatchRegisteredModel - Patch a RegisteredModel with a JSON Merge Patch or a JSON Patch
+func (s *ModelRegistryServiceAPIService) PatchRegisteredModel(ctx context.Context, registeredmodelId string, patch Patch) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	existing, err := coreApi.GetRegisteredModelById(registeredmodelId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	registeredModelUpdate := model.RegisteredModelUpdate{}
+	if err := applyPatch(existing, patch, &registeredModelUpdate); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	if err := AssertRegisteredModelUpdateRequired(registeredModelUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	if err := AssertRegisteredModelUpdateConstraints(registeredModelUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	registeredModel, err := s.converter.ConvertRegisteredModelUpdate(&registeredModelUpdate)
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	registeredModel.Id = &registeredmodelId
+	result, err := coreApi.UpsertRegisteredModel(registeredModel)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
+// PatchServingEnvironment - Patch a ServingEnvironment with a JSON Merge Patch or a JSON Patch
+func (s *ModelRegistryServiceAPIService) PatchServingEnvironment(ctx context.Context, servingenvironmentId string, patch Patch) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	existing, err := coreApi.GetServingEnvironmentById(servingenvironmentId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	servingEnvironmentUpdate := model.ServingEnvironmentUpdate{}
+	if err := applyPatch(existing, patch, &servingEnvironmentUpdate); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	if err := AssertServingEnvironmentUpdateRequired(servingEnvironmentUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	if err := AssertServingEnvironmentUpdateConstraints(servingEnvironmentUpdate); err != nil {
+		return ImplResponse{}, err
+	}
+	entity, err := s.converter.ConvertServingEnvironmentUpdate(&servingEnvironmentUpdate)
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	entity.Id = &servingenvironmentId
+	result, err := coreApi.UpsertServingEnvironment(entity)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // UpdateInferenceService - Update a InferenceService
 func (s *ModelRegistryServiceAPIService) UpdateInferenceService(ctx context.Context, inferenceserviceId string, inferenceServiceUpdate model.InferenceServiceUpdate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	entity, err := s.converter.ConvertInferenceServiceUpdate(&inferenceServiceUpdate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 	entity.Id = &inferenceserviceId
-	existing, err := s.coreApi.GetInferenceServiceById(inferenceserviceId)
+	existing, err := coreApi.GetInferenceServiceById(inferenceserviceId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	update, err := s.reconciler.UpdateExistingInferenceService(converter.NewOpenapiUpdateWrapper(existing, entity))
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.UpsertInferenceService(&update)
+	result, err := coreApi.UpsertInferenceService(&update)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// UpdateInferenceServiceServe - Update a ServeModel action of an InferenceService
+func (s *ModelRegistryServiceAPIService) UpdateInferenceServiceServe(ctx context.Context, inferenceserviceId string, servemodelId string, serveModelUpdate model.ServeModelUpdate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	entity, err := s.converter.ConvertServeModelUpdate(&serveModelUpdate)
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	entity.Id = &servemodelId
+	existing, err := coreApi.GetServeModelById(servemodelId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	update, err := s.reconciler.UpdateExistingServeModel(converter.NewOpenapiUpdateWrapper(existing, entity))
+	if err != nil {
+		return BadRequestResponse(ctx, err), nil
+	}
+	result, err := coreApi.UpsertServeModel(&update, &inferenceserviceId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // UpdateModelArtifact - Update a ModelArtifact
-func (s *ModelRegistryServiceAPIService) UpdateModelArtifact(ctx context.Context, modelartifactId string, modelArtifactUpdate model.ModelArtifactUpdate) (ImplResponse, error) {
+func (s *ModelRegistryServiceAPIService) UpdateModelArtifact(ctx context.Context, modelartifactId string, modelArtifactUpdate model.ModelArtifactUpdate, allowDigestChange bool) (ImplResponse, error) {
+	if allowDigestChange {
+		ctx = api.WithDigestChangeAllowed(ctx)
+	}
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	modelArtifact, err := s.converter.ConvertModelArtifactUpdate(&modelArtifactUpdate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 	modelArtifact.Id = &modelartifactId
-	existing, err := s.coreApi.GetModelArtifactById(modelartifactId)
+	existing, err := coreApi.GetModelArtifactById(modelartifactId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	update, err := s.reconciler.UpdateExistingModelArtifact(converter.NewOpenapiUpdateWrapper(existing, modelArtifact))
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.UpsertModelArtifact(&update, nil)
+	result, err := coreApi.UpsertModelArtifact(&update, nil)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -497,24 +941,26 @@ func (s *ModelRegistryServiceAPIService) UpdateModelArtifact(ctx context.Context
 
 // UpdateModelVersion - Update a ModelVersion
 func (s *ModelRegistryServiceAPIService) UpdateModelVersion(ctx context.Context, modelversionId string, modelVersionUpdate model.ModelVersionUpdate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	modelVersion, err := s.converter.ConvertModelVersionUpdate(&modelVersionUpdate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 	modelVersion.Id = &modelversionId
-	existing, err := s.coreApi.GetModelVersionById(modelversionId)
+	existing, err := coreApi.GetModelVersionById(modelversionId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	update, err := s.reconciler.UpdateExistingModelVersion(converter.NewOpenapiUpdateWrapper(existing, modelVersion))
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.UpsertModelVersion(&update, nil)
+	result, err := coreApi.UpsertModelVersion(&update, nil)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
@@ -522,49 +968,67 @@ func (s *ModelRegistryServiceAPIService) UpdateModelVersion(ctx context.Context,
 
 // UpdateRegisteredModel - Update a RegisteredModel
 func (s *ModelRegistryServiceAPIService) UpdateRegisteredModel(ctx context.Context, registeredmodelId string, registeredModelUpdate model.RegisteredModelUpdate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	registeredModel, err := s.converter.ConvertRegisteredModelUpdate(&registeredModelUpdate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 	registeredModel.Id = &registeredmodelId
-	existing, err := s.coreApi.GetRegisteredModelById(registeredmodelId)
+	existing, err := coreApi.GetRegisteredModelById(registeredmodelId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	update, err := s.reconciler.UpdateExistingRegisteredModel(converter.NewOpenapiUpdateWrapper(existing, registeredModel))
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.UpsertRegisteredModel(&update)
+	result, err := coreApi.UpsertRegisteredModel(&update)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
 }
 
+// UpdateRegisteredModelPropertySchema - Attach a PropertySchema to a RegisteredModel
+func (s *ModelRegistryServiceAPIService) UpdateRegisteredModelPropertySchema(ctx context.Context, registeredmodelId string, entityType string, propertySchema model.PropertySchema) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	propertySchema.EntityType = &entityType
+	result, err := coreApi.UpsertPropertySchema(&propertySchema, registeredmodelId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // UpdateServingEnvironment - Update a ServingEnvironment
 func (s *ModelRegistryServiceAPIService) UpdateServingEnvironment(ctx context.Context, servingenvironmentId string, servingEnvironmentUpdate model.ServingEnvironmentUpdate) (ImplResponse, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
 	entity, err := s.converter.ConvertServingEnvironmentUpdate(&servingEnvironmentUpdate)
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
 	entity.Id = &servingenvironmentId
-	existing, err := s.coreApi.GetServingEnvironmentById(servingenvironmentId)
+	existing, err := coreApi.GetServingEnvironmentById(servingenvironmentId)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	update, err := s.reconciler.UpdateExistingServingEnvironment(converter.NewOpenapiUpdateWrapper(existing, entity))
 	if err != nil {
-		return Response(http.StatusBadRequest, model.Error{Message: err.Error()}), nil
+		return BadRequestResponse(ctx, err), nil
 	}
-	result, err := s.coreApi.UpsertServingEnvironment(&update)
+	result, err := coreApi.UpsertServingEnvironment(&update)
 	if err != nil {
-		status := api.ErrToStatus(err)
-		return Response(status, model.Error{Message: err.Error()}), nil
+		return ErrorResponse(ctx, err), nil
 	}
 	return Response(http.StatusOK, result), nil
 	// TODO return Response(http.StatusUnauthorized, Error{}), nil
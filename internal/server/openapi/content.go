@@ -0,0 +1,42 @@
+package openapi
+
+import (
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+)
+
+// Content is a response body served as is, with its content type, instead of being encoded as JSON
+type Content struct {
+	ContentType string
+	// FileName, if set, serves the content as an attachment with this file name
+	FileName string
+	// Reader is closed once served, if it is an io.Closer
+	Reader io.Reader
+}
+
+// EncodeResponse serves Content bodies as is, and encodes any other body as JSON
+func EncodeResponse(i interface{}, status *int, w http.ResponseWriter) {
+	content, ok := i.(*Content)
+	if !ok {
+		EncodeJSONResponse(i, status, w)
+		return
+	}
+	if closer, ok := content.Reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	w.Header().Set("Content-Type", content.ContentType)
+	if content.FileName != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": content.FileName}))
+	}
+	if status != nil {
+		w.WriteHeader(*status)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	if _, err := io.Copy(w, content.Reader); err != nil {
+		// too late to report the error to the client
+		slog.Error("error writing response content", "error", err)
+	}
+}
@@ -0,0 +1,195 @@
+package openapi
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubeflow/model-registry/internal/objectstore"
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// WithStorageCredential serves the content of the model artifacts referencing storageKey from store, when stored in
+// one of the allowedPrefixes directories
+func WithStorageCredential(storageKey string, store objectstore.Store, allowedPrefixes ...string) ServiceOption {
+	return func(s *ModelRegistryServiceAPIService) {
+		s.objectStores[storageKey] = store
+		s.allowedPrefixes[storageKey] = allowedPrefixes
+	}
+}
+
+// GetModelArtifactContent - Download the content of a ModelArtifact
+func (s *ModelRegistryServiceAPIService) GetModelArtifactContent(ctx context.Context, modelartifactId string) (ImplResponse, error) {
+	modelArtifact, store, key, err := s.modelArtifactContent(ctx, modelartifactId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+
+	object, err := store.Get(ctx, key)
+	if err == nil {
+		slog.InfoContext(ctx, "downloading model artifact content", "id", modelartifactId, "uri", modelArtifact.GetUri())
+		return Response(http.StatusOK, &Content{ContentType: "application/octet-stream", FileName: path.Base(key), Reader: object}), nil
+	}
+	if !errors.Is(err, objectstore.ErrNotExist) {
+		return ErrorResponse(ctx, fmt.Errorf("error reading content of model artifact %s: %w", modelartifactId, err)), nil
+	}
+
+	// the uri may point to a directory, served as a tar archive
+	keys, err := store.List(ctx, key)
+	if err != nil {
+		return ErrorResponse(ctx, fmt.Errorf("error listing content of model artifact %s: %w", modelartifactId, err)), nil
+	}
+	if len(keys) == 0 {
+		return ErrorResponse(ctx, fmt.Errorf("no content found at uri %s of model artifact %s: %w", modelArtifact.GetUri(), modelartifactId, api.ErrNotFound)), nil
+	}
+	slog.InfoContext(ctx, "downloading model artifact content", "id", modelartifactId, "uri", modelArtifact.GetUri(), "files", len(keys))
+	reader, writer := io.Pipe()
+	go func() {
+		// the archive is written while being served, closing the reader once served stops the writes
+		writer.CloseWithError(writeTar(ctx, writer, store, key, keys))
+	}()
+	return Response(http.StatusOK, &Content{ContentType: "application/x-tar", FileName: path.Base(key) + ".tar", Reader: reader}), nil
+}
+
+// PresignModelArtifact - Get presigned URLs of the content of a ModelArtifact
+func (s *ModelRegistryServiceAPIService) PresignModelArtifact(ctx context.Context, modelartifactId string, expiresIn int32) (ImplResponse, error) {
+	modelArtifact, store, key, err := s.modelArtifactContent(ctx, modelartifactId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	presigner, ok := store.(objectstore.Presigner)
+	if !ok {
+		return ErrorResponse(ctx, fmt.Errorf("presigned URLs are only supported on S3 compatible storage, model artifact %s is stored at %s: %w", modelartifactId, modelArtifact.GetUri(), api.ErrBadRequest)), nil
+	}
+
+	// presigning doesn't check whether objects exist, only uris of directories are listed
+	keys, err := store.List(ctx, key)
+	if err != nil {
+		return ErrorResponse(ctx, fmt.Errorf("error listing content of model artifact %s: %w", modelartifactId, err)), nil
+	}
+	expires := time.Duration(expiresIn) * time.Second
+	expiration := time.Now().Add(expires)
+	urls := []model.PresignedUrl{}
+	if len(keys) == 0 {
+		presigned, err := presigner.Presign(key, expires)
+		if err != nil {
+			return ErrorResponse(ctx, err), nil
+		}
+		urls = append(urls, model.PresignedUrl{Url: presigned})
+	}
+	for _, file := range keys {
+		presigned, err := presigner.Presign(file, expires)
+		if err != nil {
+			return ErrorResponse(ctx, err), nil
+		}
+		filePath := strings.TrimPrefix(file, key+"/")
+		urls = append(urls, model.PresignedUrl{Path: &filePath, Url: presigned})
+	}
+	slog.InfoContext(ctx, "presigning model artifact content", "id", modelartifactId, "uri", modelArtifact.GetUri(), "files", len(urls), "expires_in", expires)
+	return Response(http.StatusOK, model.ModelArtifactPresignedUrls{
+		Urls:                     urls,
+		ExpirationTimeSinceEpoch: strconv.FormatInt(expiration.UnixMilli(), 10),
+	}), nil
+}
+
+// modelArtifactContent returns the model artifact, read with the authorization of the caller, and the store and key
+// of its content: the store of the storage credential referenced by its storageKey, or the upload store when none is
+func (s *ModelRegistryServiceAPIService) modelArtifactContent(ctx context.Context, modelartifactId string) (*model.ModelArtifact, objectstore.Store, string, error) {
+	coreApi, err := s.coreApiFor(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	modelArtifact, err := coreApi.GetModelArtifactById(modelartifactId)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	store, key, err := s.contentStore(modelArtifact.GetStorageKey(), modelArtifact.GetUri())
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("content of model artifact %s is not available, %v: %w", modelartifactId, err, api.ErrNotFound)
+	}
+	// the uri and storageKey are set by callers, who must not get access to other content of the storage
+	if !s.servable(modelArtifact.GetStorageKey(), modelArtifact.GetId(), key) {
+		return nil, nil, "", fmt.Errorf("content of model artifact %s is not available, uri %s is outside the content served by the registry: %w", modelartifactId, modelArtifact.GetUri(), api.ErrNotFound)
+	}
+	return modelArtifact, store, key, nil
+}
+
+// servable reports whether the content at key may be served for the model artifact id: content in the allowed
+// prefixes of the storage credential referenced by storageKey, or uploaded for the model artifact to the upload store
+func (s *ModelRegistryServiceAPIService) servable(storageKey string, id string, key string) bool {
+	if prefixes, ok := s.allowedPrefixes[storageKey]; ok && storageKey != "" {
+		return slices.ContainsFunc(prefixes, func(prefix string) bool {
+			prefix = strings.Trim(prefix, "/")
+			return prefix == "" || key == prefix || strings.HasPrefix(key, prefix+"/")
+		})
+	}
+	return uploadedFor(id, key)
+}
+
+// uploadedFor reports whether key is in the directory of an upload of the model artifact id, i.e. {id}/{uploadId},
+// as written by UploadModelArtifact
+func uploadedFor(id string, key string) bool {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) < 2 || parts[0] != id {
+		return false
+	}
+	_, err := uuid.Parse(parts[1])
+	return err == nil
+}
+
+// contentStore returns the store and key of the content at uri: the store of the storage credential referenced by
+// storageKey, or the upload store when storageKey is empty
+func (s *ModelRegistryServiceAPIService) contentStore(storageKey string, uri string) (objectstore.Store, string, error) {
+	store := s.objectStore
+	if storageKey != "" {
+		if store = s.objectStores[storageKey]; store == nil {
+			return nil, "", fmt.Errorf("storage key %s is not configured in the registry", storageKey)
+		}
+	}
+	if store == nil {
+		return nil, "", errors.New("no storage is configured in the registry")
+	}
+	key, ok := store.Key(uri)
+	if !ok {
+		return nil, "", fmt.Errorf("uri %s is not in the storage configured in the registry", uri)
+	}
+	return store, key, nil
+}
+
+// writeTar writes the objects of the directory dir, listed in keys, as a tar archive of the files of the directory
+func writeTar(ctx context.Context, w io.Writer, store objectstore.Store, dir string, keys []string) error {
+	tw := tar.NewWriter(w)
+	for _, key := range keys {
+		if err := writeTarFile(ctx, tw, store, key, strings.TrimPrefix(key, dir+"/")); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func writeTarFile(ctx context.Context, tw *tar.Writer, store objectstore.Store, key string, name string) error {
+	object, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+	if object.Size < 0 {
+		return fmt.Errorf("unknown size of object %s", key)
+	}
+	if err := tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Size: object.Size, Mode: 0o644}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, object)
+	return err
+}
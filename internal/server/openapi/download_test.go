@@ -0,0 +1,180 @@
+package openapi
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/objectstore"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// presigningStore presigns URLs of a fake S3 service
+type presigningStore struct {
+	objectstore.Store
+}
+
+func (p presigningStore) Presign(key string, expires time.Duration) (string, error) {
+	return "https://s3.example.com/" + key + "?expires=" + expires.String(), nil
+}
+
+// uploadDir is the directory of an upload of the model artifact 3, as written by UploadModelArtifact
+const (
+	uploadId  = "0b9b3e0c-5d52-4a8e-8d8c-1a2f6c7e9b41"
+	uploadDir = "3/" + uploadId
+)
+
+func TestGetModelArtifactContent(t *testing.T) {
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: t.TempDir(), URIPrefix: "pvc://models"})
+	require.NoError(t, err)
+	ctx := context.Background()
+	// content registered out-of-band, served through the storage credential
+	require.NoError(t, store.Put(ctx, "mnist/v1/model.onnx", strings.NewReader("weights")))
+	require.NoError(t, store.Put(ctx, "mnist/v1/config/labels.txt", strings.NewReader("cat\n")))
+	require.NoError(t, store.Put(ctx, "mnistic/model.onnx", strings.NewReader("weights")))
+	require.NoError(t, store.Put(ctx, "private/model.onnx", strings.NewReader("weights")))
+	coreApi := &fakeArtifactApi{artifact: &model.ModelArtifact{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/mnist/v1/model.onnx"), StorageKey: apiutils.Of("models-pvc")}}
+	s := NewModelRegistryServiceAPIService(coreApi, WithStorageCredential("models-pvc", store, "/mnist/")).(*ModelRegistryServiceAPIService)
+
+	result, err := s.GetModelArtifactContent(ctx, "3")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	w := httptest.NewRecorder()
+	EncodeResponse(result.Body, &result.Code, w)
+	assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=model.onnx", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "weights", w.Body.String())
+
+	coreApi.artifact.Uri = apiutils.Of("pvc://models/mnist/v1")
+	result, err = s.GetModelArtifactContent(ctx, "3")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	w = httptest.NewRecorder()
+	EncodeResponse(result.Body, &result.Code, w)
+	assert.Equal(t, "application/x-tar", w.Header().Get("Content-Type"))
+	archive := tar.NewReader(w.Body)
+	files := map[string]string{}
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(archive)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+	assert.Equal(t, map[string]string{"config/labels.txt": "cat\n", "model.onnx": "weights"}, files)
+
+	for _, artifact := range []*model.ModelArtifact{
+		{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/mnist/v2"), StorageKey: apiutils.Of("models-pvc")},
+		{Id: apiutils.Of("3"), Uri: apiutils.Of("s3://models/mnist/v1"), StorageKey: apiutils.Of("models-pvc")},
+		{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/mnist/v1"), StorageKey: apiutils.Of("aws-connection")},
+		{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/mnist/v1")},
+		// content outside the allowed prefixes of the storage credential
+		{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/private/model.onnx"), StorageKey: apiutils.Of("models-pvc")},
+		{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/mnistic/model.onnx"), StorageKey: apiutils.Of("models-pvc")},
+	} {
+		coreApi.artifact = artifact
+		result, err = s.GetModelArtifactContent(ctx, "3")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, result.Code, artifact.GetUri())
+	}
+
+	// storage credentials without allowed prefixes don't serve any content
+	coreApi.artifact = &model.ModelArtifact{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/mnist/v1"), StorageKey: apiutils.Of("models-pvc")}
+	s = NewModelRegistryServiceAPIService(coreApi, WithStorageCredential("models-pvc", store)).(*ModelRegistryServiceAPIService)
+	result, err = s.GetModelArtifactContent(ctx, "3")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, result.Code)
+}
+
+func TestGetModelArtifactContentUploaded(t *testing.T) {
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: t.TempDir(), URIPrefix: "pvc://models"})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, uploadDir+"/model.onnx", strings.NewReader("weights")))
+	require.NoError(t, store.Put(ctx, "3/other/model.onnx", strings.NewReader("weights")))
+	require.NoError(t, store.Put(ctx, "4/"+uploadId+"/model.onnx", strings.NewReader("weights")))
+	coreApi := &fakeArtifactApi{artifact: &model.ModelArtifact{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/" + uploadDir + "/model.onnx"), StorageKey: apiutils.Of("models-pvc")}}
+	s := NewModelRegistryServiceAPIService(coreApi, WithObjectStore(store, "models-pvc")).(*ModelRegistryServiceAPIService)
+
+	result, err := s.GetModelArtifactContent(ctx, "3")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, result.Code)
+	result.Body.(*Content).Reader.(io.Closer).Close()
+
+	// artifacts without storageKey are served from the upload storage too
+	coreApi.artifact = &model.ModelArtifact{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/" + uploadDir)}
+	result, err = s.GetModelArtifactContent(ctx, "3")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, result.Code)
+	result.Body.(*Content).Reader.(io.Closer).Close()
+
+	// the upload storage only serves the content uploaded for the model artifact
+	for _, uri := range []string{"pvc://models/3", "pvc://models/3/other/model.onnx", "pvc://models/4/" + uploadId + "/model.onnx"} {
+		coreApi.artifact = &model.ModelArtifact{Id: apiutils.Of("3"), Uri: apiutils.Of(uri), StorageKey: apiutils.Of("models-pvc")}
+		result, err = s.GetModelArtifactContent(ctx, "3")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, result.Code, uri)
+	}
+}
+
+func TestPresignModelArtifact(t *testing.T) {
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: t.TempDir(), URIPrefix: "s3://models"})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, uploadDir+"/model.onnx", strings.NewReader("weights")))
+	require.NoError(t, store.Put(ctx, uploadDir+"/config/labels.txt", strings.NewReader("cat\n")))
+	coreApi := &fakeArtifactApi{artifact: &model.ModelArtifact{Id: apiutils.Of("3"), Uri: apiutils.Of("s3://models/" + uploadDir + "/model.onnx"), StorageKey: apiutils.Of("aws-connection")}}
+	s := NewModelRegistryServiceAPIService(coreApi, WithStorageCredential("aws-connection", presigningStore{store}, "3")).(*ModelRegistryServiceAPIService)
+
+	result, err := s.PresignModelArtifact(ctx, "3", 60)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	presigned := result.Body.(model.ModelArtifactPresignedUrls)
+	assert.Equal(t, []model.PresignedUrl{{Url: "https://s3.example.com/" + uploadDir + "/model.onnx?expires=1m0s"}}, presigned.Urls)
+	assert.NotEmpty(t, presigned.ExpirationTimeSinceEpoch)
+
+	coreApi.artifact.Uri = apiutils.Of("s3://models/" + uploadDir)
+	result, err = s.PresignModelArtifact(ctx, "3", 60)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	assert.Equal(t, []model.PresignedUrl{
+		{Path: apiutils.Of("config/labels.txt"), Url: "https://s3.example.com/" + uploadDir + "/config/labels.txt?expires=1m0s"},
+		{Path: apiutils.Of("model.onnx"), Url: "https://s3.example.com/" + uploadDir + "/model.onnx?expires=1m0s"},
+	}, result.Body.(model.ModelArtifactPresignedUrls).Urls)
+
+	s = NewModelRegistryServiceAPIService(coreApi, WithStorageCredential("aws-connection", store, "3")).(*ModelRegistryServiceAPIService)
+	result, err = s.PresignModelArtifact(ctx, "3", 60)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, result.Code, "filesystem storage can't presign URLs")
+}
+
+// TestWriteTarStorageInitializer checks that the tar archives of directories are the ones the storage initializer
+// extracts in its tests, see csi/pkg/storage/resume_test.go
+func TestWriteTarStorageInitializer(t *testing.T) {
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: t.TempDir()})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, "3/model.onnx", strings.NewReader("weights")))
+	require.NoError(t, store.Put(ctx, "3/config/labels.txt", strings.NewReader("cat\ndog\n")))
+	keys, err := store.List(ctx, "3")
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	require.NoError(t, writeTar(ctx, &archive, store, "3", keys))
+	expected, err := os.ReadFile("../../../csi/pkg/storage/testdata/content.tar")
+	require.NoError(t, err)
+	assert.Equal(t, expected, archive.Bytes())
+}
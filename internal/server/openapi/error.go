@@ -10,9 +10,14 @@
 package openapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+
+	"github.com/kubeflow/model-registry/internal/logging"
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
 )
 
 var (
@@ -47,16 +52,53 @@ func (e *RequiredError) Error() string {
 type ErrorHandler func(w http.ResponseWriter, r *http.Request, err error, result *ImplResponse)
 
 // DefaultErrorHandler defines the default logic on how to handle errors from the controller. Any errors from parsing
-// request params will return a StatusBadRequest. Otherwise, the error code originating from the servicer will be used.
+// request params will return a StatusBadRequest, missing required fields a StatusUnprocessableEntity. Otherwise, the
+// error code originating from the servicer will be used.
 func DefaultErrorHandler(w http.ResponseWriter, r *http.Request, err error, result *ImplResponse) {
 	if _, ok := err.(*ParsingError); ok {
 		// Handle parsing errors
-		EncodeJSONResponse(err.Error(), func(i int) *int { return &i }(http.StatusBadRequest), w)
-	} else if _, ok := err.(*RequiredError); ok {
+		response := BadRequestResponse(r.Context(), err)
+		EncodeJSONResponse(response.Body, &response.Code, w)
+	} else if requiredErr, ok := err.(*RequiredError); ok {
 		// Handle missing required errors
-		EncodeJSONResponse(err.Error(), func(i int) *int { return &i }(http.StatusUnprocessableEntity), w)
+		response := ErrorResponse(r.Context(), api.NewValidationError(api.FieldError{Field: requiredErr.Field, Message: "required field is missing"}))
+		EncodeJSONResponse(response.Body, &response.Code, w)
 	} else {
 		// Handle all other errors
-		EncodeJSONResponse(err.Error(), &result.Code, w)
+		status := api.ErrToStatus(err)
+		if result != nil && result.Code != 0 {
+			status = result.Code
+		}
+		EncodeJSONResponse(errorBody(r.Context(), err), &status, w)
+	}
+}
+
+// ErrorResponse returns the response reporting err, with the HTTP status and code of its api error class and the id
+// of the request carried by ctx
+func ErrorResponse(ctx context.Context, err error) ImplResponse {
+	return Response(api.ErrToStatus(err), errorBody(ctx, err))
+}
+
+// BadRequestResponse returns the response reporting err as a bad request, whatever its api error class
+func BadRequestResponse(ctx context.Context, err error) ImplResponse {
+	body := model.Error{Code: api.CodeBadRequest, Message: err.Error()}
+	if id := logging.RequestId(ctx); id != "" {
+		body.RequestId = &id
+	}
+	return Response(http.StatusBadRequest, body)
+}
+
+// errorBody builds the structured error body reporting err, including its field violations if any
+func errorBody(ctx context.Context, err error) model.Error {
+	body := model.Error{
+		Code:    api.ErrToCode(err),
+		Message: err.Error(),
+	}
+	if id := logging.RequestId(ctx); id != "" {
+		body.RequestId = &id
+	}
+	for _, field := range api.ErrToDetails(err) {
+		body.Details = append(body.Details, model.ErrorDetail{Field: field.Field, Message: field.Message})
 	}
+	return body
 }
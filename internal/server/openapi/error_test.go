@@ -0,0 +1,59 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/logging"
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponseRequestId(t *testing.T) {
+	coreApi := &fakeServeModelApi{serveModel: &model.ServeModel{Id: apiutils.Of("7"), ModelVersionId: "2"}}
+	router := logging.RequestIdMiddleware(NewTenantRouter(DefaultTenantHeader, NewModelRegistryServiceAPIController(NewModelRegistryServiceAPIService(coreApi))))
+
+	testCases := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+		code   string
+	}{
+		{
+			name:   "service error",
+			path:   "/api/model_registry/v1alpha3/inference_services/2/serves/7",
+			body:   `{"lastKnownState":"FAILED"}`,
+			status: http.StatusNotFound,
+			code:   api.CodeNotFound,
+		},
+		{
+			name:   "parsing error",
+			path:   "/api/model_registry/v1alpha3/inference_services/1/serves/7",
+			body:   `{"lastKnownState":`,
+			status: http.StatusBadRequest,
+			code:   api.CodeBadRequest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(logging.RequestIdHeader, "req-1")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.status, rec.Code, rec.Body.String())
+			var body model.Error
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			assert.Equal(t, tc.code, body.Code)
+			assert.Equal(t, "req-1", body.GetRequestId(), "the error body should include the request id")
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/kubeflow/model-registry/internal/objectstore"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/inspector"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// GetModelArtifactInspection - Inspect the content of a ModelArtifact
+func (s *ModelRegistryServiceAPIService) GetModelArtifactInspection(ctx context.Context, modelartifactId string) (ImplResponse, error) {
+	modelArtifact, store, key, err := s.modelArtifactContent(ctx, modelartifactId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	inspection, err := inspectContent(ctx, store, key)
+	if err != nil {
+		return ErrorResponse(ctx, fmt.Errorf("error inspecting content of model artifact %s: %w", modelartifactId, err)), nil
+	}
+	slog.InfoContext(ctx, "inspected model artifact content", "id", modelartifactId, "uri", modelArtifact.GetUri(), "format", inspection.GetModelFormatName())
+	return Response(http.StatusOK, inspection), nil
+}
+
+// fillFromInspection sets the model format and signature of a new model artifact, when not set, to the ones detected
+// from the files at its uri
+func (s *ModelRegistryServiceAPIService) fillFromInspection(ctx context.Context, modelArtifact *model.ModelArtifact) error {
+	if modelArtifact.ModelFormatName != nil && modelArtifact.ModelFormatVersion != nil && modelArtifact.Signature != nil {
+		return nil
+	}
+	store, key, err := s.contentStore(modelArtifact.GetStorageKey(), modelArtifact.GetUri())
+	if err != nil {
+		return fmt.Errorf("can't inspect model artifact at %s, %v: %w", modelArtifact.GetUri(), err, api.ErrBadRequest)
+	}
+	inspection, err := inspectContent(ctx, store, key)
+	if errors.Is(err, api.ErrNotFound) {
+		return fmt.Errorf("can't inspect model artifact: %v: %w", err, api.ErrBadRequest)
+	}
+	if err != nil {
+		return fmt.Errorf("error inspecting model artifact at %s: %w", modelArtifact.GetUri(), err)
+	}
+	// the detected version and signature only apply to the detected format
+	if modelArtifact.ModelFormatName != nil && modelArtifact.GetModelFormatName() != inspection.GetModelFormatName() {
+		return nil
+	}
+	if modelArtifact.ModelFormatName == nil {
+		modelArtifact.ModelFormatName = inspection.ModelFormatName
+	}
+	if modelArtifact.ModelFormatVersion == nil {
+		modelArtifact.ModelFormatVersion = inspection.ModelFormatVersion
+	}
+	if modelArtifact.Signature == nil {
+		modelArtifact.Signature = inspection.Signature
+	}
+	slog.InfoContext(ctx, "inspected new model artifact content", "uri", modelArtifact.GetUri(), "format", inspection.GetModelFormatName())
+	return nil
+}
+
+// inspectContent detects the model format of the file, or directory of files, at key in store
+func inspectContent(ctx context.Context, store objectstore.Store, key string) (*model.ModelArtifactInspection, error) {
+	artifact := &storedArtifact{ctx: ctx, store: store, dir: key}
+	object, err := store.Get(ctx, key)
+	if err == nil {
+		object.Close()
+		artifact.dir, artifact.files = path.Dir(key), []string{path.Base(key)}
+	} else if !errors.Is(err, objectstore.ErrNotExist) {
+		return nil, err
+	} else {
+		keys, err := store.List(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("no content found at %s: %w", key, api.ErrNotFound)
+		}
+		for _, file := range keys {
+			artifact.files = append(artifact.files, strings.TrimPrefix(file, key+"/"))
+		}
+	}
+	return inspector.Inspect(artifact)
+}
+
+// storedArtifact gives the inspector access to the files of a model artifact, under dir in store
+type storedArtifact struct {
+	ctx   context.Context
+	store objectstore.Store
+	dir   string
+	files []string
+}
+
+func (a *storedArtifact) Files() []string {
+	return a.files
+}
+
+func (a *storedArtifact) Open(file string) (io.ReadCloser, error) {
+	object, err := a.store.Get(a.ctx, path.Join(a.dir, file))
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
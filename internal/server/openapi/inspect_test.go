@@ -0,0 +1,74 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/objectstore"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ggufHeader starts a GGUF file of version 3
+const ggufHeader = "GGUF\x03\x00\x00\x00\x23\x01\x00\x00\x00\x00\x00\x00"
+
+func TestGetModelArtifactInspection(t *testing.T) {
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: t.TempDir(), URIPrefix: "pvc://models"})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, uploadDir+"/llama/model.gguf", strings.NewReader(ggufHeader)))
+	require.NoError(t, store.Put(ctx, uploadDir+"/llama/README.md", strings.NewReader("# Llama")))
+	coreApi := &fakeArtifactApi{artifact: &model.ModelArtifact{Id: apiutils.Of("3"), Uri: apiutils.Of("pvc://models/" + uploadDir + "/llama"), StorageKey: apiutils.Of("models-pvc")}}
+	s := NewModelRegistryServiceAPIService(coreApi, WithStorageCredential("models-pvc", store, "3")).(*ModelRegistryServiceAPIService)
+
+	result, err := s.GetModelArtifactInspection(ctx, "3")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	assert.Equal(t, &model.ModelArtifactInspection{ModelFormatName: apiutils.Of("gguf"), ModelFormatVersion: apiutils.Of("3"), File: apiutils.Of("model.gguf")}, result.Body)
+	assert.Nil(t, coreApi.artifact.ModelFormatName, "inspections should not change the model artifact")
+
+	coreApi.artifact.Uri = apiutils.Of("pvc://models/" + uploadDir + "/llama/model.gguf")
+	result, err = s.GetModelArtifactInspection(ctx, "3")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	assert.Equal(t, "gguf", result.Body.(*model.ModelArtifactInspection).GetModelFormatName())
+
+	coreApi.artifact.Uri = apiutils.Of("pvc://models/4")
+	result, err = s.GetModelArtifactInspection(ctx, "3")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, result.Code)
+}
+
+func TestCreateModelArtifactInspect(t *testing.T) {
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: t.TempDir(), URIPrefix: "pvc://models"})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, "llama/model.gguf", strings.NewReader(ggufHeader)))
+	coreApi := &fakeArtifactApi{}
+	s := NewModelRegistryServiceAPIService(coreApi, WithStorageCredential("models-pvc", store)).(*ModelRegistryServiceAPIService)
+
+	result, err := s.CreateModelArtifact(ctx, model.ModelArtifactCreate{Uri: apiutils.Of("pvc://models/llama"), StorageKey: apiutils.Of("models-pvc")}, true)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, result.Code)
+	assert.Equal(t, "gguf", coreApi.artifact.GetModelFormatName())
+	assert.Equal(t, "3", coreApi.artifact.GetModelFormatVersion())
+
+	// formats set by hand are kept
+	result, err = s.CreateModelArtifact(ctx, model.ModelArtifactCreate{Uri: apiutils.Of("pvc://models/llama"), StorageKey: apiutils.Of("models-pvc"), ModelFormatName: apiutils.Of("vllm")}, true)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, result.Code)
+	assert.Equal(t, "vllm", coreApi.artifact.GetModelFormatName())
+	assert.Nil(t, coreApi.artifact.ModelFormatVersion)
+
+	result, err = s.CreateModelVersionArtifact(ctx, "2", model.Artifact{ModelArtifact: &model.ModelArtifact{Uri: apiutils.Of("s3://models/llama"), StorageKey: apiutils.Of("models-pvc")}}, true)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, result.Code)
+
+	result, err = s.CreateModelArtifact(ctx, model.ModelArtifactCreate{Uri: apiutils.Of("pvc://models/mistral"), StorageKey: apiutils.Of("models-pvc")}, true)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, result.Code)
+}
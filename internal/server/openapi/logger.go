@@ -10,7 +10,7 @@
 package openapi
 
 import (
-	"log"
+	"log/slog"
 	"net/http"
 	"time"
 )
@@ -21,12 +21,11 @@ func Logger(inner http.Handler, name string) http.Handler {
 
 		inner.ServeHTTP(w, r)
 
-		log.Printf(
-			"%s %s %s %s",
-			r.Method,
-			r.RequestURI,
-			name,
-			time.Since(start),
+		slog.InfoContext(r.Context(), "request served",
+			"method", r.Method,
+			"uri", r.RequestURI,
+			"route", name,
+			"duration", time.Since(start),
 		)
 	})
 }
@@ -0,0 +1,34 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/kubeflow/model-registry/pkg/modelcard"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// WithModelCardRenderer renders model cards with renderer, instead of the default templates
+func WithModelCardRenderer(renderer *modelcard.Renderer) ServiceOption {
+	return func(s *ModelRegistryServiceAPIService) {
+		s.modelCards = renderer
+	}
+}
+
+// renderModelCard returns the response serving card in format, the model card itself when JSON
+func (s *ModelRegistryServiceAPIService) renderModelCard(ctx context.Context, card *model.ModelCard, format string) ImplResponse {
+	cardFormat, err := modelcard.ParseFormat(format)
+	if err != nil {
+		return ErrorResponse(ctx, err)
+	}
+	if cardFormat == modelcard.JSON {
+		return Response(http.StatusOK, card)
+	}
+	var rendered bytes.Buffer
+	if err := s.modelCards.Render(&rendered, card, cardFormat); err != nil {
+		return ErrorResponse(ctx, fmt.Errorf("error rendering model card: %w", err))
+	}
+	return Response(http.StatusOK, &Content{ContentType: cardFormat.ContentType(), Reader: &rendered})
+}
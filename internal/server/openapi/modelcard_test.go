@@ -0,0 +1,32 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderModelCard(t *testing.T) {
+	assertion := assert.New(t)
+	s := NewModelRegistryServiceAPIService(nil).(*ModelRegistryServiceAPIService)
+	card := model.NewModelCard(model.RegisteredModel{Id: apiutils.Of("1"), Name: apiutils.Of("fraud-detector")}, []model.ModelCardVersion{})
+
+	result := s.renderModelCard(context.Background(), card, "json")
+	assertion.Equal(http.StatusOK, result.Code)
+	assertion.Equal(card, result.Body)
+
+	result = s.renderModelCard(context.Background(), card, "markdown")
+	assertion.Equal(http.StatusOK, result.Code)
+	w := httptest.NewRecorder()
+	EncodeResponse(result.Body, &result.Code, w)
+	assertion.Equal("text/markdown; charset=utf-8", w.Header().Get("Content-Type"))
+	assertion.Contains(w.Body.String(), "# fraud-detector\n")
+
+	result = s.renderModelCard(context.Background(), card, "pdf")
+	assertion.Equal(http.StatusBadRequest, result.Code)
+}
@@ -0,0 +1,138 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/kubeflow/model-registry/internal/jsonpatch"
+	"github.com/kubeflow/model-registry/pkg/api"
+)
+
+const (
+	// MergePatchContentType is the content type of JSON Merge Patch (RFC 7396) documents
+	MergePatchContentType = "application/merge-patch+json"
+	// JSONPatchContentType is the content type of JSON Patch (RFC 6902) documents
+	JSONPatchContentType = "application/json-patch+json"
+)
+
+// Patch is a JSON Merge Patch or a JSON Patch document, as told by its ContentType, sent to a PATCH route
+type Patch struct {
+	ContentType string
+	Document    []byte
+}
+
+// handlePatch serves the requests of a PATCH route carrying a JSON Merge Patch or a JSON Patch with serve, and
+// returns false for any other request, i.e. plain JSON updates, which are left to the caller.
+func (c *ModelRegistryServiceAPIController) handlePatch(w http.ResponseWriter, r *http.Request, serve func(patch Patch) (ImplResponse, error)) bool {
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if contentType != MergePatchContentType && contentType != JSONPatchContentType {
+		return false
+	}
+	document, err := io.ReadAll(r.Body)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return true
+	}
+	result, err := serve(Patch{ContentType: contentType, Document: document})
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return true
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+	return true
+}
+
+// applyPatch applies patch to the editable fields of existing, i.e. the fields of the update model T, and decodes
+// the result into update. The result is the whole editable state of the entity: fields removed by the patch are
+// unset, and custom properties removed by the patch are deleted. Patches of fields that are not editable are rejected.
+func applyPatch[T any](existing any, patch Patch, update *T) error {
+	editable := jsonFields(reflect.TypeOf(update).Elem())
+	data, err := json.Marshal(existing)
+	if err != nil {
+		return fmt.Errorf("error marshaling entity: %w", err)
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("error unmarshaling entity: %w", err)
+	}
+	for key := range fields {
+		if !editable[key] {
+			delete(fields, key)
+		}
+	}
+
+	var patched any
+	switch patch.ContentType {
+	case MergePatchContentType:
+		var members map[string]any
+		if err := json.Unmarshal(patch.Document, &members); err != nil {
+			return fmt.Errorf("invalid merge patch, expected a JSON object: %v: %w", err, api.ErrBadRequest)
+		}
+		for key := range members {
+			if !editable[key] {
+				return fmt.Errorf("invalid merge patch, field %s can't be updated: %w", key, api.ErrBadRequest)
+			}
+		}
+		patched = jsonpatch.MergePatch(fields, members)
+	case JSONPatchContentType:
+		var operations []jsonpatch.Operation
+		if err := json.Unmarshal(patch.Document, &operations); err != nil {
+			return fmt.Errorf("invalid JSON patch, expected an array of operations: %v: %w", err, api.ErrBadRequest)
+		}
+		for _, op := range operations {
+			for _, pointer := range []string{op.Path, op.From} {
+				if tokens, err := jsonpatch.ParsePointer(pointer); err == nil && len(tokens) > 0 && !editable[tokens[0]] {
+					return fmt.Errorf("invalid JSON patch, field %s can't be updated: %w", tokens[0], api.ErrBadRequest)
+				}
+			}
+		}
+		patched, err = jsonpatch.Apply(fields, operations)
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			return fmt.Errorf("%v: %w", err, api.ErrConflict)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid JSON patch, %v: %w", err, api.ErrBadRequest)
+		}
+	default:
+		return fmt.Errorf("unsupported patch content type %s: %w", patch.ContentType, api.ErrBadRequest)
+	}
+
+	result, ok := patched.(map[string]any)
+	if !ok {
+		return fmt.Errorf("invalid patch, the patched entity must be a JSON object: %w", api.ErrBadRequest)
+	}
+	if _, ok := result["customProperties"]; !ok && editable["customProperties"] {
+		// custom properties are kept when missing from updates, while here all of them were removed
+		result["customProperties"] = map[string]any{}
+	}
+	if data, err = json.Marshal(result); err != nil {
+		return fmt.Errorf("error marshaling patched entity: %w", err)
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(update); err != nil {
+		return fmt.Errorf("invalid patched entity: %v: %w", err, api.ErrBadRequest)
+	}
+	return nil
+}
+
+// jsonFields returns the JSON names of the fields of the struct type t
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
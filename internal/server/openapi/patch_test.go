@@ -0,0 +1,93 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/converter"
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func existingModelVersion() *model.ModelVersion {
+	return &model.ModelVersion{
+		Id:                apiutils.Of("2"),
+		Name:              apiutils.Of("v1"),
+		RegisteredModelId: "1",
+		Description:       apiutils.Of("first version"),
+		Author:            apiutils.Of("me"),
+		State:             model.MODELVERSIONSTATE_LIVE.Ptr(),
+		CustomProperties: &map[string]model.MetadataValue{
+			"accuracy": {MetadataDoubleValue: converter.NewMetadataDoubleValue(0.9)},
+			"stage":    {MetadataStringValue: converter.NewMetadataStringValue("dev")},
+		},
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	assertion := assert.New(t)
+
+	update := model.ModelVersionUpdate{}
+	err := applyPatch(existingModelVersion(), Patch{
+		ContentType: MergePatchContentType,
+		Document:    []byte(`{"description":null,"customProperties":{"stage":null,"owner":{"metadataType":"MetadataStringValue","string_value":"team"}}}`),
+	}, &update)
+	assertion.Nil(err)
+	assertion.Nil(update.Description, "null removes the field")
+	assertion.Equal("me", update.GetAuthor())
+	assertion.Equal(model.MODELVERSIONSTATE_LIVE, update.GetState())
+	assertion.Equal(map[string]model.MetadataValue{
+		"accuracy": {MetadataDoubleValue: converter.NewMetadataDoubleValue(0.9)},
+		"owner":    {MetadataStringValue: converter.NewMetadataStringValue("team")},
+	}, update.GetCustomProperties())
+
+	update = model.ModelVersionUpdate{}
+	assertion.Nil(applyPatch(existingModelVersion(), Patch{ContentType: MergePatchContentType, Document: []byte(`{"customProperties":null}`)}, &update))
+	assertion.NotNil(update.CustomProperties, "removing all custom properties is not the same as keeping them")
+	assertion.Empty(update.GetCustomProperties())
+
+	err = applyPatch(existingModelVersion(), Patch{ContentType: MergePatchContentType, Document: []byte(`{"name":"v2"}`)}, &model.ModelVersionUpdate{})
+	assertion.ErrorIs(err, api.ErrBadRequest)
+	err = applyPatch(existingModelVersion(), Patch{ContentType: MergePatchContentType, Document: []byte(`[]`)}, &model.ModelVersionUpdate{})
+	assertion.ErrorIs(err, api.ErrBadRequest)
+	err = applyPatch(existingModelVersion(), Patch{ContentType: MergePatchContentType, Document: []byte(`{"state":"DELETED"}`)}, &model.ModelVersionUpdate{})
+	assertion.ErrorIs(err, api.ErrBadRequest)
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	assertion := assert.New(t)
+
+	update := model.ModelVersionUpdate{}
+	err := applyPatch(existingModelVersion(), Patch{
+		ContentType: JSONPatchContentType,
+		Document: []byte(`[
+			{"op":"test","path":"/customProperties/stage/string_value","value":"dev"},
+			{"op":"replace","path":"/customProperties/stage/string_value","value":"prod"},
+			{"op":"remove","path":"/customProperties/accuracy"},
+			{"op":"replace","path":"/state","value":"ARCHIVED"}
+		]`),
+	}, &update)
+	assertion.Nil(err)
+	assertion.Equal("first version", update.GetDescription())
+	assertion.Equal(model.MODELVERSIONSTATE_ARCHIVED, update.GetState())
+	assertion.Equal(map[string]model.MetadataValue{
+		"stage": {MetadataStringValue: converter.NewMetadataStringValue("prod")},
+	}, update.GetCustomProperties())
+
+	err = applyPatch(existingModelVersion(), Patch{
+		ContentType: JSONPatchContentType,
+		Document:    []byte(`[{"op":"test","path":"/customProperties/stage/string_value","value":"prod"}]`),
+	}, &model.ModelVersionUpdate{})
+	assertion.ErrorIs(err, api.ErrConflict)
+	err = applyPatch(existingModelVersion(), Patch{
+		ContentType: JSONPatchContentType,
+		Document:    []byte(`[{"op":"copy","from":"/description","path":"/name"}]`),
+	}, &model.ModelVersionUpdate{})
+	assertion.ErrorIs(err, api.ErrBadRequest)
+	err = applyPatch(existingModelVersion(), Patch{
+		ContentType: JSONPatchContentType,
+		Document:    []byte(`[{"op":"remove","path":"/customProperties/owner"}]`),
+	}, &model.ModelVersionUpdate{})
+	assertion.ErrorIs(err, api.ErrBadRequest)
+}
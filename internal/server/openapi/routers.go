@@ -13,6 +13,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"os"
@@ -20,9 +21,8 @@ import (
 	"strings"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	"github.com/golang/glog"
+	"github.com/kubeflow/model-registry/internal/logging"
 )
 
 //lint:file-ignore U1000 Ignore all unused code, it's generated
@@ -48,13 +48,19 @@ const errMsgMaxValueConstraint = "provided parameter is not respecting maximum v
 
 // NewRouter creates a new router for any number of api routers
 func NewRouter(routers ...Router) chi.Router {
+	return NewTenantRouter(DefaultTenantHeader, routers...)
+}
+
+// NewTenantRouter creates a new router for any number of api routers, allowing cross-origin requests to send the
+// tenant in tenantHeader
+func NewTenantRouter(tenantHeader string, routers ...Router) chi.Router {
 	router := chi.NewRouter()
-	router.Use(middleware.Logger)
+	router.Use(logging.AccessLog)
 	router.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"https://*", "http://*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-PINGOTHER"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-PINGOTHER", logging.RequestIdHeader, tenantHeader},
+		ExposedHeaders:   []string{"Link", logging.RequestIdHeader},
 		AllowCredentials: false,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
@@ -80,7 +86,7 @@ func EncodeJSONResponse(i interface{}, status *int, w http.ResponseWriter) {
 	if i != nil {
 		if err := json.NewEncoder(w).Encode(i); err != nil {
 			// FIXME: is it too late to inform the client of an error at this point??
-			glog.Errorf("error encoding JSON response: %v", err)
+			slog.Error("error encoding JSON response", "error", err)
 		}
 	}
 }
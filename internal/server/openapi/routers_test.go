@@ -0,0 +1,23 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantRouterCORS(t *testing.T) {
+	for _, header := range []string{DefaultTenantHeader, "X-Team"} {
+		router := NewTenantRouter(header, NewModelRegistryServiceAPIController(NewModelRegistryServiceAPIService(&fakeServeModelApi{})))
+		req := httptest.NewRequest(http.MethodOptions, "/api/model_registry/v1alpha3/registered_models", nil)
+		req.Header.Set("Origin", "https://ui.example.com")
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		req.Header.Set("Access-Control-Request-Headers", header)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, "https://ui.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), header, "the tenant header should be allowed in cross-origin requests")
+	}
+}
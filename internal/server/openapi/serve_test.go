@@ -0,0 +1,67 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServeModelApi only implements the operations reading and updating a serve model of inference service 1
+type fakeServeModelApi struct {
+	api.ModelRegistryApi
+	serveModel *model.ServeModel
+}
+
+func (f *fakeServeModelApi) GetServeModelById(id string) (*model.ServeModel, error) {
+	if id != f.serveModel.GetId() {
+		return nil, fmt.Errorf("no ServeModel found for id %s: %w", id, api.ErrNotFound)
+	}
+	stored := *f.serveModel
+	return &stored, nil
+}
+
+func (f *fakeServeModelApi) UpsertServeModel(serveModel *model.ServeModel, inferenceServiceId *string) (*model.ServeModel, error) {
+	if *inferenceServiceId != "1" {
+		return nil, fmt.Errorf("no ServeModel found for id %s in InferenceService %s: %w", serveModel.GetId(), *inferenceServiceId, api.ErrNotFound)
+	}
+	if serveModel.ModelVersionId == "" {
+		serveModel.ModelVersionId = f.serveModel.ModelVersionId
+	}
+	f.serveModel = serveModel
+	return serveModel, nil
+}
+
+func TestUpdateInferenceServiceServe(t *testing.T) {
+	running, failed := model.EXECUTIONSTATE_RUNNING, model.EXECUTIONSTATE_FAILED
+	coreApi := &fakeServeModelApi{serveModel: &model.ServeModel{Id: apiutils.Of("7"), Name: apiutils.Of("pod-1"), ModelVersionId: "2", LastKnownState: &running}}
+	s := NewModelRegistryServiceAPIService(coreApi).(*ModelRegistryServiceAPIService)
+	ctx := context.Background()
+
+	result, err := s.UpdateInferenceServiceServe(ctx, "1", "7", model.ServeModelUpdate{LastKnownState: &failed})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	assert.Equal(t, failed, coreApi.serveModel.GetLastKnownState())
+	assert.Equal(t, "2", coreApi.serveModel.ModelVersionId)
+	assert.Equal(t, "pod-1", coreApi.serveModel.GetName())
+
+	result, err = s.PatchInferenceServiceServe(ctx, "1", "7", Patch{ContentType: MergePatchContentType, Document: []byte(`{"lastKnownState":"RUNNING","description":"loaded"}`)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	assert.Equal(t, running, coreApi.serveModel.GetLastKnownState())
+	assert.Equal(t, "loaded", coreApi.serveModel.GetDescription())
+
+	result, err = s.PatchInferenceServiceServe(ctx, "1", "7", Patch{ContentType: MergePatchContentType, Document: []byte(`{"modelVersionId":"3"}`)})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, result.Code, "the model version of a serve model is not editable")
+
+	result, err = s.UpdateInferenceServiceServe(ctx, "2", "7", model.ServeModelUpdate{LastKnownState: &failed})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, result.Code)
+}
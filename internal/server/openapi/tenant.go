@@ -0,0 +1,59 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// DefaultTenantHeader is the request header carrying the tenant when multi-tenancy is enabled
+const DefaultTenantHeader = "X-Model-Registry-Tenant"
+
+type tenantContextKey struct{}
+
+// TenantMiddleware reads the tenant from the provided request header and stores it in the request context,
+// requests missing the header are rejected since every operation must be confined to a tenant.
+// CORS preflight requests never carry custom headers, hence they are let through.
+func TenantMiddleware(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+			tenant := r.Header.Get(header)
+			if tenant == "" {
+				status := http.StatusBadRequest
+				EncodeJSONResponse(model.Error{Code: api.CodeBadRequest, Message: fmt.Sprintf("missing required tenant header %s", header)}, &status, w)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), tenantContextKey{}, tenant)))
+		})
+	}
+}
+
+// TenantFromContext returns the tenant stored in the context by TenantMiddleware, if any
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantContextKey{}).(string)
+	return tenant, ok
+}
+
+// coreApiFor returns the core api propagating the request context and confined to the tenant of the current request, if any
+func (s *ModelRegistryServiceAPIService) coreApiFor(ctx context.Context) (api.ModelRegistryApi, error) {
+	coreApi := s.coreApi
+	if contextual, ok := coreApi.(api.ContextualApi); ok {
+		coreApi = contextual.WithContext(ctx)
+	}
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return coreApi, nil
+	}
+	scoped, ok := coreApi.(api.TenantScopedApi)
+	if !ok {
+		return nil, fmt.Errorf("multi-tenancy is not supported by the configured core api")
+	}
+	return scoped.ForTenant(tenant)
+}
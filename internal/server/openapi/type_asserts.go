@@ -26,6 +26,26 @@ func AssertArtifactConstraints(obj model.Artifact) error {
 	return nil
 }
 
+// AssertArtifactDigestRequired checks if the required fields are not zero-ed
+func AssertArtifactDigestRequired(obj model.ArtifactDigest) error {
+	elements := map[string]interface{}{
+		"algorithm": obj.Algorithm,
+		"value":     obj.Value,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertArtifactDigestConstraints checks if the values respects the defined constraints
+func AssertArtifactDigestConstraints(obj model.ArtifactDigest) error {
+	return nil
+}
+
 // AssertArtifactListRequired checks if the required fields are not zero-ed
 func AssertArtifactListRequired(obj model.ArtifactList) error {
 	elements := map[string]interface{}{
@@ -52,6 +72,74 @@ func AssertArtifactListConstraints(obj model.ArtifactList) error {
 	return nil
 }
 
+// AssertArtifactSignatureRequired checks if the required fields are not zero-ed
+func AssertArtifactSignatureRequired(obj model.ArtifactSignature) error {
+	elements := map[string]interface{}{
+		"keyId":          obj.KeyId,
+		"algorithm":      obj.Algorithm,
+		"publicKey":      obj.PublicKey,
+		"signature":      obj.Signature,
+		"manifestDigest": obj.ManifestDigest,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertArtifactSignatureConstraints checks if the values respects the defined constraints
+func AssertArtifactSignatureConstraints(obj model.ArtifactSignature) error {
+	return nil
+}
+
+// AssertArtifactSignatureCreateRequired checks if the required fields are not zero-ed
+func AssertArtifactSignatureCreateRequired(obj model.ArtifactSignatureCreate) error {
+	elements := map[string]interface{}{
+		"publicKey": obj.PublicKey,
+		"signature": obj.Signature,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertArtifactSignatureCreateConstraints checks if the values respects the defined constraints
+func AssertArtifactSignatureCreateConstraints(obj model.ArtifactSignatureCreate) error {
+	return nil
+}
+
+// AssertArtifactSignatureListRequired checks if the required fields are not zero-ed
+func AssertArtifactSignatureListRequired(obj model.ArtifactSignatureList) error {
+	elements := map[string]interface{}{
+		"items": obj.Items,
+		"size":  obj.Size,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Items {
+		if err := AssertArtifactSignatureRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertArtifactSignatureListConstraints checks if the values respects the defined constraints
+func AssertArtifactSignatureListConstraints(obj model.ArtifactSignatureList) error {
+	return nil
+}
+
 // AssertArtifactStateRequired checks if the required fields are not zero-ed
 func AssertArtifactStateRequired(obj model.ArtifactState) error {
 	return nil
@@ -204,6 +292,11 @@ func AssertErrorRequired(obj model.Error) error {
 		}
 	}
 
+	for _, el := range obj.Details {
+		if err := AssertErrorDetailRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -212,6 +305,26 @@ func AssertErrorConstraints(obj model.Error) error {
 	return nil
 }
 
+// AssertErrorDetailRequired checks if the required fields are not zero-ed
+func AssertErrorDetailRequired(obj model.ErrorDetail) error {
+	elements := map[string]interface{}{
+		"field":   obj.Field,
+		"message": obj.Message,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertErrorDetailConstraints checks if the values respects the defined constraints
+func AssertErrorDetailConstraints(obj model.ErrorDetail) error {
+	return nil
+}
+
 // AssertExecutionStateRequired checks if the required fields are not zero-ed
 func AssertExecutionStateRequired(obj model.ExecutionState) error {
 	return nil
@@ -308,6 +421,26 @@ func AssertInferenceServiceUpdateConstraints(obj model.InferenceServiceUpdate) e
 	return nil
 }
 
+// AssertJsonPatchOperationRequired checks if the required fields are not zero-ed
+func AssertJsonPatchOperationRequired(obj model.JsonPatchOperation) error {
+	elements := map[string]interface{}{
+		"op":   obj.Op,
+		"path": obj.Path,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertJsonPatchOperationConstraints checks if the values respects the defined constraints
+func AssertJsonPatchOperationConstraints(obj model.JsonPatchOperation) error {
+	return nil
+}
+
 // AssertMetadataBoolValueRequired checks if the required fields are not zero-ed
 func AssertMetadataBoolValueRequired(obj model.MetadataBoolValue) error {
 	elements := map[string]interface{}{
@@ -368,6 +501,26 @@ func AssertMetadataIntValueConstraints(obj model.MetadataIntValue) error {
 	return nil
 }
 
+// AssertMetadataListValueRequired checks if the required fields are not zero-ed
+func AssertMetadataListValueRequired(obj model.MetadataListValue) error {
+	elements := map[string]interface{}{
+		"list_value":   obj.ListValue,
+		"metadataType": obj.MetadataType,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertMetadataListValueConstraints checks if the values respects the defined constraints
+func AssertMetadataListValueConstraints(obj model.MetadataListValue) error {
+	return nil
+}
+
 // AssertMetadataProtoValueRequired checks if the required fields are not zero-ed
 func AssertMetadataProtoValueRequired(obj model.MetadataProtoValue) error {
 	elements := map[string]interface{}{
@@ -441,6 +594,7 @@ func AssertMetadataValueRequired(obj model.MetadataValue) error {
 	// 	"type":         obj.Type,
 	// 	"proto_value":  obj.ProtoValue,
 	// 	"bool_value":   obj.BoolValue,
+	// 	"list_value":   obj.ListValue,
 	// }
 	// for name, el := range elements {
 	// 	if isZero := IsZeroValue(el); isZero {
@@ -467,6 +621,16 @@ func AssertModelArtifactRequired(obj model.ModelArtifact) error {
 		}
 	}
 
+	if obj.Signature != nil {
+		if err := AssertModelSignatureRequired(*obj.Signature); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Digests {
+		if err := AssertArtifactDigestRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -477,6 +641,16 @@ func AssertModelArtifactConstraints(obj model.ModelArtifact) error {
 
 // AssertModelArtifactCreateRequired checks if the required fields are not zero-ed
 func AssertModelArtifactCreateRequired(obj model.ModelArtifactCreate) error {
+	if obj.Signature != nil {
+		if err := AssertModelSignatureRequired(*obj.Signature); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Digests {
+		if err := AssertArtifactDigestRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -485,6 +659,21 @@ func AssertModelArtifactCreateConstraints(obj model.ModelArtifactCreate) error {
 	return nil
 }
 
+// AssertModelArtifactInspectionRequired checks if the required fields are not zero-ed
+func AssertModelArtifactInspectionRequired(obj model.ModelArtifactInspection) error {
+	if obj.Signature != nil {
+		if err := AssertModelSignatureRequired(*obj.Signature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertModelArtifactInspectionConstraints checks if the values respects the defined constraints
+func AssertModelArtifactInspectionConstraints(obj model.ModelArtifactInspection) error {
+	return nil
+}
+
 // AssertModelArtifactListRequired checks if the required fields are not zero-ed
 func AssertModelArtifactListRequired(obj model.ModelArtifactList) error {
 	elements := map[string]interface{}{
@@ -511,8 +700,43 @@ func AssertModelArtifactListConstraints(obj model.ModelArtifactList) error {
 	return nil
 }
 
+// AssertModelArtifactPresignedUrlsRequired checks if the required fields are not zero-ed
+func AssertModelArtifactPresignedUrlsRequired(obj model.ModelArtifactPresignedUrls) error {
+	elements := map[string]interface{}{
+		"urls":                     obj.Urls,
+		"expirationTimeSinceEpoch": obj.ExpirationTimeSinceEpoch,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Urls {
+		if err := AssertPresignedUrlRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertModelArtifactPresignedUrlsConstraints checks if the values respects the defined constraints
+func AssertModelArtifactPresignedUrlsConstraints(obj model.ModelArtifactPresignedUrls) error {
+	return nil
+}
+
 // AssertModelArtifactUpdateRequired checks if the required fields are not zero-ed
 func AssertModelArtifactUpdateRequired(obj model.ModelArtifactUpdate) error {
+	if obj.Signature != nil {
+		if err := AssertModelSignatureRequired(*obj.Signature); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Digests {
+		if err := AssertArtifactDigestRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -521,6 +745,91 @@ func AssertModelArtifactUpdateConstraints(obj model.ModelArtifactUpdate) error {
 	return nil
 }
 
+// AssertModelCardRequired checks if the required fields are not zero-ed
+func AssertModelCardRequired(obj model.ModelCard) error {
+	elements := map[string]interface{}{
+		"registeredModel": obj.RegisteredModel,
+		"versions":        obj.Versions,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	if err := AssertRegisteredModelRequired(obj.RegisteredModel); err != nil {
+		return err
+	}
+	for _, el := range obj.Versions {
+		if err := AssertModelCardVersionRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertModelCardConstraints checks if the values respects the defined constraints
+func AssertModelCardConstraints(obj model.ModelCard) error {
+	return nil
+}
+
+// AssertModelCardVersionRequired checks if the required fields are not zero-ed
+func AssertModelCardVersionRequired(obj model.ModelCardVersion) error {
+	elements := map[string]interface{}{
+		"modelVersion": obj.ModelVersion,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	if err := AssertModelVersionRequired(obj.ModelVersion); err != nil {
+		return err
+	}
+	for _, el := range obj.ModelArtifacts {
+		if err := AssertModelArtifactRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.DocArtifacts {
+		if err := AssertDocArtifactRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Deployments {
+		if err := AssertInferenceServiceRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertModelCardVersionConstraints checks if the values respects the defined constraints
+func AssertModelCardVersionConstraints(obj model.ModelCardVersion) error {
+	return nil
+}
+
+// AssertModelSignatureRequired checks if the required fields are not zero-ed
+func AssertModelSignatureRequired(obj model.ModelSignature) error {
+	for _, el := range obj.Inputs {
+		if err := AssertTensorSpecRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Outputs {
+		if err := AssertTensorSpecRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertModelSignatureConstraints checks if the values respects the defined constraints
+func AssertModelSignatureConstraints(obj model.ModelSignature) error {
+	return nil
+}
+
 // AssertModelVersionRequired checks if the required fields are not zero-ed
 func AssertModelVersionRequired(obj model.ModelVersion) error {
 	elements := map[string]interface{}{
@@ -615,6 +924,54 @@ func AssertOrderByFieldConstraints(obj model.OrderByField) error {
 	return nil
 }
 
+// AssertPresignedUrlRequired checks if the required fields are not zero-ed
+func AssertPresignedUrlRequired(obj model.PresignedUrl) error {
+	elements := map[string]interface{}{
+		"url": obj.Url,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertPresignedUrlConstraints checks if the values respects the defined constraints
+func AssertPresignedUrlConstraints(obj model.PresignedUrl) error {
+	return nil
+}
+
+// AssertPropertyDefinitionRequired checks if the required fields are not zero-ed
+func AssertPropertyDefinitionRequired(obj model.PropertyDefinition) error {
+	elements := map[string]interface{}{
+		"metadataType": obj.MetadataType,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertPropertyDefinitionConstraints checks if the values respects the defined constraints
+func AssertPropertyDefinitionConstraints(obj model.PropertyDefinition) error {
+	return nil
+}
+
+// AssertPropertySchemaRequired checks if the required fields are not zero-ed
+func AssertPropertySchemaRequired(obj model.PropertySchema) error {
+	return nil
+}
+
+// AssertPropertySchemaConstraints checks if the values respects the defined constraints
+func AssertPropertySchemaConstraints(obj model.PropertySchema) error {
+	return nil
+}
+
 // AssertRegisteredModelRequired checks if the required fields are not zero-ed
 func AssertRegisteredModelRequired(obj model.RegisteredModel) error {
 	return nil
@@ -811,6 +1168,26 @@ func AssertServingEnvironmentUpdateConstraints(obj model.ServingEnvironmentUpdat
 	return nil
 }
 
+// AssertSignatureCompatibilityRequired checks if the required fields are not zero-ed
+func AssertSignatureCompatibilityRequired(obj model.SignatureCompatibility) error {
+	elements := map[string]interface{}{
+		"compatible":    obj.Compatible,
+		"baseVersionId": obj.BaseVersionId,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertSignatureCompatibilityConstraints checks if the values respects the defined constraints
+func AssertSignatureCompatibilityConstraints(obj model.SignatureCompatibility) error {
+	return nil
+}
+
 // AssertSortOrderRequired checks if the required fields are not zero-ed
 func AssertSortOrderRequired(obj model.SortOrder) error {
 	return nil
@@ -820,3 +1197,23 @@ func AssertSortOrderRequired(obj model.SortOrder) error {
 func AssertSortOrderConstraints(obj model.SortOrder) error {
 	return nil
 }
+
+// AssertTensorSpecRequired checks if the required fields are not zero-ed
+func AssertTensorSpecRequired(obj model.TensorSpec) error {
+	elements := map[string]interface{}{
+		"name":  obj.Name,
+		"dtype": obj.Dtype,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertTensorSpecConstraints checks if the values respects the defined constraints
+func AssertTensorSpecConstraints(obj model.TensorSpec) error {
+	return nil
+}
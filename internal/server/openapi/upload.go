@@ -0,0 +1,194 @@
+package openapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/objectstore"
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/validation"
+)
+
+// uploadFormField is the name of the multipart form field of uploaded model files
+const uploadFormField = "file"
+
+// WithObjectStore enables uploads of model artifact files to store, recording storageKey, if not empty, as the
+// storage secret of uploaded model artifacts. Their content is then served from store as well.
+func WithObjectStore(store objectstore.Store, storageKey string) ServiceOption {
+	return func(s *ModelRegistryServiceAPIService) {
+		s.objectStore = store
+		s.storageKey = storageKey
+		if storageKey != "" {
+			s.objectStores[storageKey] = store
+		}
+	}
+}
+
+// WithValidator checks uploaded model artifacts with v before their files are stored, so that uploads the registry
+// would reject don't leave files behind. The core api is expected to validate them again when they're upserted.
+func WithValidator(v *validation.Validator) ServiceOption {
+	return func(s *ModelRegistryServiceAPIService) {
+		s.validator = v
+	}
+}
+
+// UploadModelArtifact - Upload the content of a ModelArtifact
+func (s *ModelRegistryServiceAPIService) UploadModelArtifact(ctx context.Context, modelartifactId string, files *multipart.Reader) (ImplResponse, error) {
+	if s.objectStore == nil {
+		return ErrorResponse(ctx, fmt.Errorf("model artifact uploads are disabled, no storage backend is configured: %w", api.ErrUnavailable)), nil
+	}
+	// uploads replace the content, and so the digests, of the model artifact
+	coreApi, err := s.coreApiFor(api.WithDigestChangeAllowed(ctx))
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	modelArtifact, err := coreApi.GetModelArtifactById(modelartifactId)
+	if err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+
+	// every upload gets its own directory, so that artifacts never point to a mix of files of different uploads
+	dir := path.Join(modelartifactId, uuid.NewString())
+	if err := s.validateUpload(modelArtifact, dir); err != nil {
+		return ErrorResponse(ctx, err), nil
+	}
+	digests, err := s.putFiles(ctx, dir, files)
+	if err != nil {
+		s.deleteFiles(ctx, dir)
+		return ErrorResponse(ctx, err), nil
+	}
+	key := dir
+	if len(digests) == 1 {
+		key = path.Join(dir, digests[0].GetPath())
+		digests[0].Path = nil
+	}
+	stored := s.uploaded(modelArtifact, key)
+	stored.Digests = digests
+
+	previous, replaced := s.uploadDir(modelArtifact)
+	result, err := coreApi.UpsertModelArtifact(stored, nil)
+	if err != nil {
+		s.deleteFiles(ctx, dir)
+		return ErrorResponse(ctx, err), nil
+	}
+	if replaced {
+		s.deleteFiles(ctx, previous)
+	}
+	return Response(http.StatusOK, result), nil
+}
+
+// uploadDir returns the directory of the files of the model artifact in the upload store, when they were uploaded
+// through the registry
+func (s *ModelRegistryServiceAPIService) uploadDir(modelArtifact *model.ModelArtifact) (string, bool) {
+	if modelArtifact.GetStorageKey() != s.storageKey {
+		return "", false
+	}
+	key, ok := s.objectStore.Key(modelArtifact.GetUri())
+	if !ok || !uploadedFor(modelArtifact.GetId(), key) {
+		return "", false
+	}
+	parts := strings.SplitN(key, "/", 3)
+	return path.Join(parts[0], parts[1]), true
+}
+
+// uploaded returns a copy of modelArtifact pointing to the files uploaded under key
+func (s *ModelRegistryServiceAPIService) uploaded(modelArtifact *model.ModelArtifact, key string) *model.ModelArtifact {
+	stored := *modelArtifact
+	stored.Uri = apiutils.Of(s.objectStore.URI(key))
+	stored.StoragePath = apiutils.Of(s.objectStore.Path(key))
+	if s.storageKey != "" {
+		stored.StorageKey = apiutils.Of(s.storageKey)
+	}
+	return &stored
+}
+
+// validateUpload checks the model artifact as it will be stored once its files are uploaded to dir, its digests and
+// the file name ending the URI of single file uploads being only known then
+func (s *ModelRegistryServiceAPIService) validateUpload(modelArtifact *model.ModelArtifact, dir string) error {
+	if s.validator == nil {
+		return nil
+	}
+	e, err := validation.NewEntity(validation.ModelArtifact, s.uploaded(modelArtifact, dir), modelArtifact)
+	if err != nil {
+		return err
+	}
+	return s.validator.Validate(e)
+}
+
+// deleteFiles removes the files of a failed or replaced upload, even when the request was canceled
+func (s *ModelRegistryServiceAPIService) deleteFiles(ctx context.Context, dir string) {
+	if err := s.objectStore.Delete(context.WithoutCancel(ctx), dir); err != nil {
+		slog.ErrorContext(ctx, "error deleting files of model artifact upload", "key", dir, "error", err)
+	}
+}
+
+// putFiles streams the files of the multipart upload to the object store under dir, returning their sha256 digests
+func (s *ModelRegistryServiceAPIService) putFiles(ctx context.Context, dir string, files *multipart.Reader) ([]model.ArtifactDigest, error) {
+	digests := []model.ArtifactDigest{}
+	seen := map[string]bool{}
+	for {
+		part, err := files.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading uploaded files: %v: %w", err, api.ErrBadRequest)
+		}
+		name, err := uploadedFileName(part)
+		if err != nil {
+			part.Close()
+			return nil, err
+		}
+		if seen[name] {
+			part.Close()
+			return nil, fmt.Errorf("file %s is uploaded more than once: %w", name, api.ErrBadRequest)
+		}
+		seen[name] = true
+
+		hash := sha256.New()
+		err = s.objectStore.Put(ctx, path.Join(dir, name), io.TeeReader(part, hash))
+		part.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error storing file %s: %w", name, err)
+		}
+		digests = append(digests, model.ArtifactDigest{
+			Path:      apiutils.Of(name),
+			Algorithm: "sha256",
+			Value:     hex.EncodeToString(hash.Sum(nil)),
+		})
+	}
+	if len(digests) == 0 {
+		return nil, fmt.Errorf("missing %s parts in upload: %w", uploadFormField, api.ErrBadRequest)
+	}
+	return digests, nil
+}
+
+// uploadedFileName returns the path of the file uploaded in part, relative to the model directory.
+// The file name is read from the Content-Disposition header, as part.FileName() drops its directories.
+func uploadedFileName(part *multipart.Part) (string, error) {
+	if part.FormName() != uploadFormField {
+		return "", fmt.Errorf("unexpected form field %s, expected %s: %w", part.FormName(), uploadFormField, api.ErrBadRequest)
+	}
+	_, params, err := mime.ParseMediaType(part.Header.Get("Content-Disposition"))
+	if err != nil {
+		return "", fmt.Errorf("invalid Content-Disposition of uploaded file: %v: %w", err, api.ErrBadRequest)
+	}
+	name := params["filename"]
+	if !objectstore.ValidKey(name) {
+		return "", fmt.Errorf("invalid uploaded file name %q, expected a relative path using / as separator: %w", name, api.ErrBadRequest)
+	}
+	return name, nil
+}
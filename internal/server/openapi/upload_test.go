@@ -0,0 +1,169 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/objectstore"
+	"github.com/kubeflow/model-registry/pkg/api"
+	model "github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeArtifactApi only implements the operations reading and updating a model artifact, failing updates with err
+type fakeArtifactApi struct {
+	api.ModelRegistryApi
+	artifact *model.ModelArtifact
+	err      error
+}
+
+func (f *fakeArtifactApi) GetModelArtifactById(id string) (*model.ModelArtifact, error) {
+	if id != f.artifact.GetId() {
+		return nil, fmt.Errorf("no model artifact found for id %s: %w", id, api.ErrNotFound)
+	}
+	stored := *f.artifact
+	return &stored, nil
+}
+
+func (f *fakeArtifactApi) UpsertModelArtifact(modelArtifact *model.ModelArtifact, modelVersionId *string) (*model.ModelArtifact, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.artifact = modelArtifact
+	return modelArtifact, nil
+}
+
+// multipartFiles returns a reader of the multipart upload of files, by file name
+func multipartFiles(t *testing.T, field string, files ...[2]string) *multipart.Reader {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for _, file := range files {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, field, file[0]))
+		part, err := w.CreatePart(header)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(file[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return multipart.NewReader(&body, w.Boundary())
+}
+
+func TestUploadModelArtifact(t *testing.T) {
+	root := t.TempDir()
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: root, URIPrefix: "pvc://models"})
+	require.NoError(t, err)
+	coreApi := &fakeArtifactApi{artifact: &model.ModelArtifact{Id: apiutils.Of("3"), Name: apiutils.Of("model")}}
+	s := NewModelRegistryServiceAPIService(coreApi, WithObjectStore(store, "models-pvc")).(*ModelRegistryServiceAPIService)
+	ctx := context.Background()
+
+	result, err := s.UploadModelArtifact(ctx, "3", multipartFiles(t, "file", [2]string{"model.onnx", "weights"}))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	uploaded := result.Body.(*model.ModelArtifact)
+	assert.Regexp(t, `^pvc://models/3/[0-9a-f-]{36}/model\.onnx$`, uploaded.GetUri())
+	assert.Equal(t, strings.TrimPrefix(uploaded.GetUri(), "pvc://models/"), uploaded.GetStoragePath())
+	assert.Equal(t, "models-pvc", uploaded.GetStorageKey())
+	assert.Equal(t, []model.ArtifactDigest{{Algorithm: "sha256", Value: "9a129038d9a00aed0cf6a7ea059ca50a813449061ab87848cf1a13eafdf33b2c"}}, uploaded.Digests)
+	content, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(uploaded.GetStoragePath())))
+	require.NoError(t, err)
+	assert.Equal(t, "weights", string(content))
+	first := path.Dir(uploaded.GetStoragePath())
+
+	result, err = s.UploadModelArtifact(ctx, "3", multipartFiles(t, "file", [2]string{"1/model.onnx", "weights"}, [2]string{"1/config/labels.txt", "cat\n"}))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	uploaded = result.Body.(*model.ModelArtifact)
+	assert.Regexp(t, `^pvc://models/3/[0-9a-f-]{36}$`, uploaded.GetUri())
+	assert.Equal(t, []model.ArtifactDigest{
+		{Path: apiutils.Of("1/model.onnx"), Algorithm: "sha256", Value: "9a129038d9a00aed0cf6a7ea059ca50a813449061ab87848cf1a13eafdf33b2c"},
+		{Path: apiutils.Of("1/config/labels.txt"), Algorithm: "sha256", Value: "175cc6f362b2f75acd08a373e000144fdb8d14a833d4b70fd743f16a7039103f"},
+	}, uploaded.Digests)
+	content, err = os.ReadFile(filepath.Join(root, filepath.FromSlash(uploaded.GetStoragePath()), "1", "config", "labels.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "cat\n", string(content))
+	uploads, err := os.ReadDir(filepath.Join(root, "3"))
+	require.NoError(t, err)
+	require.Len(t, uploads, 1, "files of the replaced upload should be deleted")
+	assert.Equal(t, uploaded.GetStoragePath(), path.Join("3", uploads[0].Name()))
+	assert.NotEqual(t, first, uploaded.GetStoragePath())
+
+	for _, files := range []*multipart.Reader{
+		multipartFiles(t, "file"),
+		multipartFiles(t, "model", [2]string{"model.onnx", "weights"}),
+		multipartFiles(t, "file", [2]string{"../model.onnx", "weights"}),
+		multipartFiles(t, "file", [2]string{"model.onnx", "weights"}, [2]string{"model.onnx", "weights"}),
+	} {
+		result, err = s.UploadModelArtifact(ctx, "3", files)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, result.Code)
+	}
+	assert.Equal(t, uploaded, coreApi.artifact, "failed uploads should not change the model artifact")
+	uploads, err = os.ReadDir(filepath.Join(root, "3"))
+	require.NoError(t, err)
+	assert.Len(t, uploads, 1, "files of failed uploads should be deleted")
+
+	result, err = s.UploadModelArtifact(ctx, "4", multipartFiles(t, "file", [2]string{"model.onnx", "weights"}))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, result.Code)
+
+	disabled := NewModelRegistryServiceAPIService(coreApi).(*ModelRegistryServiceAPIService)
+	result, err = disabled.UploadModelArtifact(ctx, "3", multipartFiles(t, "file", [2]string{"model.onnx", "weights"}))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, result.Code)
+}
+
+func TestUploadModelArtifactFailures(t *testing.T) {
+	root := t.TempDir()
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: root, URIPrefix: "pvc://models"})
+	require.NoError(t, err)
+	validator, err := validation.NewValidator(validation.Config{UriSchemes: []string{"s3"}})
+	require.NoError(t, err)
+	coreApi := &fakeArtifactApi{artifact: &model.ModelArtifact{Id: apiutils.Of("3"), Name: apiutils.Of("model")}}
+	ctx := context.Background()
+
+	s := NewModelRegistryServiceAPIService(coreApi, WithObjectStore(store, ""), WithValidator(validator)).(*ModelRegistryServiceAPIService)
+	result, err := s.UploadModelArtifact(ctx, "3", multipartFiles(t, "file", [2]string{"model.onnx", "weights"}))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, result.Code)
+	assert.Contains(t, fmt.Sprint(result.Body), "pvc")
+	_, err = os.Stat(filepath.Join(root, "3"))
+	assert.True(t, os.IsNotExist(err), "invalid uploads should not be stored")
+
+	coreApi.err = fmt.Errorf("connection lost: %w", api.ErrUnavailable)
+	s = NewModelRegistryServiceAPIService(coreApi, WithObjectStore(store, "")).(*ModelRegistryServiceAPIService)
+	result, err = s.UploadModelArtifact(ctx, "3", multipartFiles(t, "file", [2]string{"model.onnx", "weights"}, [2]string{"config/labels.txt", "cat\n"}))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, result.Code)
+	uploads, err := os.ReadDir(filepath.Join(root, "3"))
+	require.NoError(t, err)
+	assert.Empty(t, uploads, "files of uploads failing to update the model artifact should be deleted")
+}
+
+func TestUploadModelArtifactKeepsContentNotUploaded(t *testing.T) {
+	root := t.TempDir()
+	store, err := objectstore.New(objectstore.Config{Type: objectstore.TypeFilesystem, Path: root, URIPrefix: "pvc://models"})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, "3/model.onnx", strings.NewReader("weights")))
+	coreApi := &fakeArtifactApi{artifact: &model.ModelArtifact{Id: apiutils.Of("3"), Name: apiutils.Of("model"), Uri: apiutils.Of("pvc://models/3/model.onnx")}}
+	s := NewModelRegistryServiceAPIService(coreApi, WithObjectStore(store, "")).(*ModelRegistryServiceAPIService)
+
+	result, err := s.UploadModelArtifact(ctx, "3", multipartFiles(t, "file", [2]string{"model.onnx", "weights"}))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.Code)
+	_, err = os.Stat(filepath.Join(root, "3", "model.onnx"))
+	assert.NoError(t, err, "content not uploaded through the registry should be kept")
+}
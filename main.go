@@ -1,7 +1,6 @@
 package main
 
 import (
-	"github.com/golang/glog"
 	"github.com/kubeflow/model-registry/cmd"
 	"log"
 	"net/http"
@@ -9,8 +8,6 @@ import (
 )
 
 func main() {
-	defer glog.Flush()
-
 	// start pprof server on 6060
 	go func() {
 		log.Println(http.ListenAndServe("localhost:6060", nil))
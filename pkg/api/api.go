@@ -1,6 +1,10 @@
 package api
 
-import "github.com/kubeflow/model-registry/pkg/openapi"
+import (
+	"context"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
 
 // ListOptions provides options for listing entities with pagination and sorting.
 // It includes parameters such as PageSize, OrderBy, SortOrder, and NextPageToken.
@@ -12,6 +16,10 @@ type ListOptions struct {
 }
 
 // ModelRegistryApi defines the external API for the Model Registry library
+//
+// When updating an entity, nil CustomProperties keep the stored custom properties, otherwise they replace them: only
+// the added, changed and removed custom properties are written, so that concurrent updates of other keys are kept,
+// and the stored custom properties with the registry reserved _mr. prefix are always kept.
 type ModelRegistryApi interface {
 	// REGISTERED MODEL
 
@@ -115,7 +123,8 @@ type ModelRegistryApi interface {
 
 	// UpsertServeModel create or update a serve model, the behavior follows the same
 	// approach used by MLMD gRPC api. If Id is provided update the entity otherwise create a new one.
-	// inferenceServiceId defines the InferenceService to be linked to the newly created ServeModel.
+	// inferenceServiceId defines the InferenceService to be linked to the newly created ServeModel, or the one an updated
+	// ServeModel must belong to when set.
 	UpsertServeModel(serveModel *openapi.ServeModel, inferenceServiceId *string) (*openapi.ServeModel, error)
 
 	// GetServeModelById retrieve ServeModel by id
@@ -124,4 +133,43 @@ type ModelRegistryApi interface {
 	// GetServeModels get all ServeModel objects properly ordered and sized based on listOptions param.
 	// if inferenceServiceId is provided, return all ServeModel instances belonging to a specific InferenceService
 	GetServeModels(listOptions ListOptions, inferenceServiceId *string) (*openapi.ServeModelList, error)
+
+	// PROPERTY SCHEMA
+
+	// GetPropertySchema retrieve the schema the custom properties of entityType entities must comply with.
+	// If registeredModelId is provided, return the schema attached to that RegisteredModel, if any,
+	// otherwise return the global one.
+	GetPropertySchema(entityType string, registeredModelId *string) (*openapi.PropertySchema, error)
+
+	// UpsertPropertySchema attach a custom properties schema, for the entity type set in propertySchema, to the
+	// RegisteredModel identified by registeredModelId, replacing the previous one
+	UpsertPropertySchema(propertySchema *openapi.PropertySchema, registeredModelId string) (*openapi.PropertySchema, error)
+
+	// MODEL ARTIFACT SIGNATURE
+
+	// GetModelArtifactSignatures return all the signatures of the ModelArtifact identified by modelArtifactId
+	GetModelArtifactSignatures(modelArtifactId string) (*openapi.ArtifactSignatureList, error)
+
+	// UpsertModelArtifactSignature attach a signature of the digest manifest to the ModelArtifact identified by
+	// modelArtifactId, replacing the previous one made with the same key
+	UpsertModelArtifactSignature(signature *openapi.ArtifactSignatureCreate, modelArtifactId string) (*openapi.ArtifactSignature, error)
+
+	// DeleteModelArtifactSignature remove the signature made with the key identified by keyId from the ModelArtifact
+	// identified by modelArtifactId
+	DeleteModelArtifactSignature(modelArtifactId string, keyId string) error
+}
+
+// TenantScopedApi is optionally implemented by ModelRegistryApi instances supporting multi-tenancy,
+// callers check for it with a type assertion
+type TenantScopedApi interface {
+	// ForTenant return a ModelRegistryApi confined to the provided tenant, entities belonging
+	// to any other tenant can be neither retrieved nor updated through it.
+	ForTenant(tenant string) (ModelRegistryApi, error)
+}
+
+// ContextualApi is implemented by ModelRegistryApi instances able to propagate a context,
+// carrying deadlines and trace information, to the underlying MLMD calls, callers check for it with a type assertion
+type ContextualApi interface {
+	// WithContext return a ModelRegistryApi propagating the provided context to every MLMD call
+	WithContext(ctx context.Context) ModelRegistryApi
 }
@@ -0,0 +1,18 @@
+package api
+
+import "context"
+
+type digestChangeContextKey struct{}
+
+// WithDigestChangeAllowed returns a copy of ctx allowing updates to change or remove the digests already recorded
+// on a model artifact, which are rejected otherwise
+func WithDigestChangeAllowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, digestChangeContextKey{}, true)
+}
+
+// DigestChangeAllowed returns whether ctx allows updates to change or remove the digests of a model artifact,
+// see WithDigestChangeAllowed
+func DigestChangeAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(digestChangeContextKey{}).(bool)
+	return allowed
+}
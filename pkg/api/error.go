@@ -2,21 +2,101 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
 var (
-	ErrBadRequest = errors.New("bad request")
-	ErrNotFound   = errors.New("not found")
+	ErrBadRequest         = errors.New("bad request")
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrForbidden          = errors.New("forbidden")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrValidation         = errors.New("validation failed")
 )
 
+// Error codes returned in the code field of REST error bodies
+const (
+	CodeBadRequest         = "BAD_REQUEST"
+	CodeNotFound           = "NOT_FOUND"
+	CodeConflict           = "CONFLICT"
+	CodePreconditionFailed = "PRECONDITION_FAILED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeUnavailable        = "UNAVAILABLE"
+	CodeValidation         = "VALIDATION_FAILED"
+	CodeInternal           = "INTERNAL"
+)
+
+// errorClasses maps each error class to its code and HTTP status, in matching order
+var errorClasses = []struct {
+	err    error
+	code   string
+	status int
+}{
+	{ErrValidation, CodeValidation, http.StatusUnprocessableEntity},
+	{ErrBadRequest, CodeBadRequest, http.StatusBadRequest},
+	{ErrNotFound, CodeNotFound, http.StatusNotFound},
+	{ErrConflict, CodeConflict, http.StatusConflict},
+	{ErrPreconditionFailed, CodePreconditionFailed, http.StatusPreconditionFailed},
+	{ErrForbidden, CodeForbidden, http.StatusForbidden},
+	{ErrUnavailable, CodeUnavailable, http.StatusServiceUnavailable},
+}
+
+// FieldError describes a violation of a single field
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError reports all the field violations of a request, it matches ErrValidation
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// NewValidationError creates a ValidationError reporting the provided field violations
+func NewValidationError(fields ...FieldError) *ValidationError {
+	return &ValidationError{Fields: fields}
+}
+
+func (e *ValidationError) Error() string {
+	violations := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		violations = append(violations, fmt.Sprintf("%s: %s", f.Field, f.Message))
+	}
+	return fmt.Sprintf("%s: %s", ErrValidation, strings.Join(violations, "; "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// ErrToStatus returns the HTTP status of the error class err belongs to, 500 for unclassified errors
 func ErrToStatus(err error) int {
-	switch errors.Unwrap(err) {
-	case ErrBadRequest:
-		return http.StatusBadRequest
-	case ErrNotFound:
-		return http.StatusNotFound
-	default:
-		return http.StatusInternalServerError
+	for _, class := range errorClasses {
+		if errors.Is(err, class.err) {
+			return class.status
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+// ErrToCode returns the code of the error class err belongs to, CodeInternal for unclassified errors
+func ErrToCode(err error) string {
+	for _, class := range errorClasses {
+		if errors.Is(err, class.err) {
+			return class.code
+		}
+	}
+	return CodeInternal
+}
+
+// ErrToDetails returns the field violations carried by err, if any
+func ErrToDetails(err error) []FieldError {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Fields
 	}
+	return nil
 }
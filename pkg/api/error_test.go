@@ -0,0 +1,47 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrToStatusAndCode(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{fmt.Errorf("invalid id: %w", ErrBadRequest), http.StatusBadRequest, CodeBadRequest},
+		{fmt.Errorf("no model found: %w", ErrNotFound), http.StatusNotFound, CodeNotFound},
+		{fmt.Errorf("duplicate name: %w", ErrConflict), http.StatusConflict, CodeConflict},
+		{fmt.Errorf("version changed: %w", ErrPreconditionFailed), http.StatusPreconditionFailed, CodePreconditionFailed},
+		{fmt.Errorf("denied: %w", ErrForbidden), http.StatusForbidden, CodeForbidden},
+		{fmt.Errorf("mlmd down: %w", ErrUnavailable), http.StatusServiceUnavailable, CodeUnavailable},
+		{NewValidationError(FieldError{Field: "name", Message: "required"}), http.StatusUnprocessableEntity, CodeValidation},
+		{fmt.Errorf("wrapped twice: %w", fmt.Errorf("inner: %w", ErrNotFound)), http.StatusNotFound, CodeNotFound},
+		{errors.New("boom"), http.StatusInternalServerError, CodeInternal},
+	} {
+		assert.Equal(t, tc.status, ErrToStatus(tc.err), tc.err.Error())
+		assert.Equal(t, tc.code, ErrToCode(tc.err), tc.err.Error())
+	}
+}
+
+func TestValidationErrorDetails(t *testing.T) {
+	assertion := assert.New(t)
+
+	err := fmt.Errorf("invalid model: %w", NewValidationError(
+		FieldError{Field: "name", Message: "required field is missing"},
+		FieldError{Field: "customProperties.owner", Message: "must be a string"},
+	))
+	assertion.True(errors.Is(err, ErrValidation))
+	assertion.Equal("invalid model: validation failed: name: required field is missing; customProperties.owner: must be a string", err.Error())
+	assertion.Equal([]FieldError{
+		{Field: "name", Message: "required field is missing"},
+		{Field: "customProperties.owner", Message: "must be a string"},
+	}, ErrToDetails(err))
+	assertion.Nil(ErrToDetails(ErrNotFound))
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/converter"
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/signing"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// signaturePrefix prefixes the custom properties of model artifacts holding their signatures, one per signing key
+const signaturePrefix = reservedPrefix + "signature."
+
+// GetModelArtifactSignatures retrieves the signatures of the model artifact identified by modelArtifactId, ordered
+// by key ID.
+func (serv *ModelRegistryService) GetModelArtifactSignatures(modelArtifactId string) (*openapi.ArtifactSignatureList, error) {
+	serv, span := serv.startSpan("GetModelArtifactSignatures")
+	defer span.End()
+
+	modelArtifact, err := serv.GetModelArtifactById(modelArtifactId)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := modelArtifact.GetCustomProperties()
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		if strings.HasPrefix(key, signaturePrefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	signatures := make([]openapi.ArtifactSignature, 0, len(keys))
+	for _, key := range keys {
+		value := properties[key]
+		if value.MetadataStringValue == nil {
+			continue
+		}
+		signature := openapi.ArtifactSignature{}
+		if err := json.Unmarshal([]byte(value.MetadataStringValue.StringValue), &signature); err != nil {
+			return nil, fmt.Errorf("invalid signature %s of model artifact %s: %w", strings.TrimPrefix(key, signaturePrefix), modelArtifactId, err)
+		}
+		signatures = append(signatures, signature)
+	}
+	return openapi.NewArtifactSignatureList(signatures, int32(len(signatures))), nil
+}
+
+// UpsertModelArtifactSignature attaches the provided signature to the model artifact identified by modelArtifactId,
+// replacing the previous one made with the same key, if any. The signature must verify against the current digest
+// manifest of the model artifact, see signing.Manifest.
+// The signature is stored in a reserved custom property of the model artifact.
+func (serv *ModelRegistryService) UpsertModelArtifactSignature(signature *openapi.ArtifactSignatureCreate, modelArtifactId string) (*openapi.ArtifactSignature, error) {
+	serv, span := serv.startSpan("UpsertModelArtifactSignature")
+	defer span.End()
+
+	if signature == nil {
+		return nil, fmt.Errorf("invalid signature pointer, can't upsert nil: %w", api.ErrBadRequest)
+	}
+	modelArtifact, err := serv.GetModelArtifactById(modelArtifactId)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := signing.Manifest(modelArtifact.Digests)
+	if err != nil {
+		return nil, fmt.Errorf("model artifact %s can't be signed, it has no digests: %w", modelArtifactId, api.ErrBadRequest)
+	}
+
+	key, err := signing.ParsePublicKey([]byte(signature.PublicKey))
+	if err != nil {
+		return nil, api.NewValidationError(api.FieldError{Field: "publicKey", Message: err.Error()})
+	}
+	keyId, err := signing.KeyID(key)
+	if err != nil {
+		return nil, api.NewValidationError(api.FieldError{Field: "publicKey", Message: err.Error()})
+	}
+	algorithm, err := signing.Algorithm(key)
+	if err != nil {
+		return nil, api.NewValidationError(api.FieldError{Field: "publicKey", Message: err.Error()})
+	}
+	if err := signing.VerifyEncoded(key, manifest, signature.Signature); err != nil {
+		return nil, api.NewValidationError(api.FieldError{Field: "signature", Message: fmt.Sprintf("does not verify against the current digests of the model artifact: %v", err)})
+	}
+
+	stored := openapi.NewArtifactSignature(keyId, algorithm, signature.PublicKey, signature.Signature, signing.ManifestDigest(manifest))
+	stored.Signer = signature.Signer
+	stored.CreateTimeSinceEpoch = apiutils.Of(strconv.FormatInt(time.Now().UnixMilli(), 10))
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling signature: %w", err)
+	}
+	if err := serv.putArtifactCustomProperty(modelArtifactId, signaturePrefix+keyId, &proto.Value{
+		Value: &proto.Value_StringValue{
+			StringValue: string(data),
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+// DeleteModelArtifactSignature removes the signature made with the key identified by keyId from the model artifact
+// identified by modelArtifactId.
+func (serv *ModelRegistryService) DeleteModelArtifactSignature(modelArtifactId string, keyId string) error {
+	serv, span := serv.startSpan("DeleteModelArtifactSignature")
+	defer span.End()
+
+	modelArtifact, err := serv.GetModelArtifactById(modelArtifactId)
+	if err != nil {
+		return err
+	}
+	if _, ok := modelArtifact.GetCustomProperties()[signaturePrefix+keyId]; !ok {
+		return fmt.Errorf("no signature found for key %s on model artifact %s: %w", keyId, modelArtifactId, api.ErrNotFound)
+	}
+	return serv.putArtifactCustomProperty(modelArtifactId, signaturePrefix+keyId, nil)
+}
+
+// clearSignatures removes the signatures from the custom properties of modelArtifact, e.g. once its digests changed
+func clearSignatures(modelArtifact *openapi.ModelArtifact) {
+	if modelArtifact.CustomProperties == nil {
+		return
+	}
+	for key := range *modelArtifact.CustomProperties {
+		if strings.HasPrefix(key, signaturePrefix) {
+			delete(*modelArtifact.CustomProperties, key)
+		}
+	}
+}
+
+// putArtifactCustomProperty sets the custom property key of the artifact identified by id, removing it when value
+// is nil, leaving the other fields and custom properties of the artifact untouched. As custom properties with the
+// reserved prefix are always kept by updates, this is the only way to remove them.
+func (serv *ModelRegistryService) putArtifactCustomProperty(id string, key string, value *proto.Value) error {
+	idAsInt, err := converter.StringToInt64(&id)
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, api.ErrBadRequest)
+	}
+	artifactsResp, err := serv.mlmdClient.GetArtifactsByID(serv.ctx, &proto.GetArtifactsByIDRequest{
+		ArtifactIds: []int64{*idAsInt},
+	})
+	if err != nil {
+		return err
+	}
+	if len(artifactsResp.Artifacts) != 1 || !serv.ownedByTenant(artifactsResp.Artifacts[0].Properties) {
+		return fmt.Errorf("no artifact found for id %s: %w", id, api.ErrNotFound)
+	}
+
+	artifact := artifactsResp.Artifacts[0]
+	artifact.CustomProperties = map[string]*proto.Value{}
+	if value != nil {
+		artifact.CustomProperties[key] = value
+	}
+	_, err = serv.mlmdClient.PutArtifacts(serv.ctx, &proto.PutArtifactsRequest{
+		Artifacts:  []*proto.Artifact{artifact},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_properties." + key}},
+	})
+	return err
+}
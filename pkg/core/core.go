@@ -3,12 +3,13 @@ package core
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 
-	"github.com/golang/glog"
 	"github.com/kubeflow/model-registry/internal/apiutils"
 	"github.com/kubeflow/model-registry/internal/converter"
 	"github.com/kubeflow/model-registry/internal/converter/generated"
+	"github.com/kubeflow/model-registry/internal/defaults"
 	"github.com/kubeflow/model-registry/internal/mapper"
 	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
 	"github.com/kubeflow/model-registry/internal/mlmdtypes"
@@ -24,30 +25,48 @@ type ModelRegistryService struct {
 	mapper      *mapper.Mapper
 	openapiConv *generated.OpenAPIConverterImpl
 	nameConfig  mlmdtypes.MLMDTypeNamesConfig
+	// propertySchemas are the global custom property schemas by entity type, see WithPropertySchemas
+	propertySchemas map[string]openapi.PropertySchema
+	tenant          string          // optional, confines reads and writes to a single tenant, see ForTenant
+	ctx             context.Context // propagated to every MLMD call, see WithContext
 }
 
+// Option configures an optional feature of the ModelRegistryService
+type Option func(serv *ModelRegistryService) error
+
 // NewModelRegistryService creates a new instance of the ModelRegistryService, initializing it with the provided gRPC client connection.
 // It _assumes_ the necessary MLMD's Context, Artifact, Execution types etc. are already setup in the underlying MLMD service.
 //
 // Parameters:
 //   - cc: A gRPC client connection to the underlying MLMD service
-func NewModelRegistryService(cc grpc.ClientConnInterface, nameConfig mlmdtypes.MLMDTypeNamesConfig) (api.ModelRegistryApi, error) {
+//   - nameConfig: The names of the MLMD types
+//   - opts: Optional features, e.g. WithPropertySchemas
+func NewModelRegistryService(cc grpc.ClientConnInterface, nameConfig mlmdtypes.MLMDTypeNamesConfig, opts ...Option) (api.ModelRegistryApi, error) {
 	typesMap, err := BuildTypesMap(cc, nameConfig)
 	if err != nil { // early return in case type Ids cannot be retrieved
 		return nil, err
 	}
 
-	client := proto.NewMetadataStoreServiceClient(cc)
+	client := proto.NewMetadataStoreServiceClient(errorTranslatingConn{cc})
 
-	return &ModelRegistryService{
+	serv := &ModelRegistryService{
 		mlmdClient:  client,
 		nameConfig:  nameConfig,
 		typesMap:    typesMap,
 		openapiConv: &generated.OpenAPIConverterImpl{},
 		mapper:      mapper.NewMapper(typesMap),
-	}, nil
+		ctx:         context.Background(),
+	}
+	for _, opt := range opts {
+		if err := opt(serv); err != nil {
+			return nil, err
+		}
+	}
+	return serv, nil
 }
 
+// BuildTypesMap retrieves the ids of the MLMD types named after the provided config, the returned map is keyed by
+// the default type names, acting as logical names regardless of the MLMD type names actually configured.
 func BuildTypesMap(cc grpc.ClientConnInterface, nameConfig mlmdtypes.MLMDTypeNamesConfig) (map[string]int64, error) {
 	client := proto.NewMetadataStoreServiceClient(cc)
 
@@ -101,13 +120,13 @@ func BuildTypesMap(cc grpc.ClientConnInterface, nameConfig mlmdtypes.MLMDTypeNam
 	}
 
 	typesMap := map[string]int64{
-		nameConfig.RegisteredModelTypeName:    registeredModelResp.ContextType.GetId(),
-		nameConfig.ModelVersionTypeName:       modelVersionResp.ContextType.GetId(),
-		nameConfig.DocArtifactTypeName:        docArtifactResp.ArtifactType.GetId(),
-		nameConfig.ModelArtifactTypeName:      modelArtifactResp.ArtifactType.GetId(),
-		nameConfig.ServingEnvironmentTypeName: servingEnvironmentResp.ContextType.GetId(),
-		nameConfig.InferenceServiceTypeName:   inferenceServiceResp.ContextType.GetId(),
-		nameConfig.ServeModelTypeName:         serveModelResp.ExecutionType.GetId(),
+		defaults.RegisteredModelTypeName:    registeredModelResp.ContextType.GetId(),
+		defaults.ModelVersionTypeName:       modelVersionResp.ContextType.GetId(),
+		defaults.DocArtifactTypeName:        docArtifactResp.ArtifactType.GetId(),
+		defaults.ModelArtifactTypeName:      modelArtifactResp.ArtifactType.GetId(),
+		defaults.ServingEnvironmentTypeName: servingEnvironmentResp.ContextType.GetId(),
+		defaults.InferenceServiceTypeName:   inferenceServiceResp.ContextType.GetId(),
+		defaults.ServeModelTypeName:         serveModelResp.ExecutionType.GetId(),
 	}
 	return typesMap, nil
 }
@@ -117,13 +136,16 @@ func BuildTypesMap(cc grpc.ClientConnInterface, nameConfig mlmdtypes.MLMDTypeNam
 // UpsertRegisteredModel creates a new registered model if the given registered model's ID is nil,
 // or updates an existing registered model if the ID is provided.
 func (serv *ModelRegistryService) UpsertRegisteredModel(registeredModel *openapi.RegisteredModel) (*openapi.RegisteredModel, error) {
+	serv, span := serv.startSpan("UpsertRegisteredModel")
+	defer span.End()
+
 	var err error
 	var existing *openapi.RegisteredModel
 
 	if registeredModel.Id == nil {
-		glog.Info("Creating new registered model")
+		slog.InfoContext(serv.ctx, "creating new registered model")
 	} else {
-		glog.Infof("Updating registered model %s", *registeredModel.Id)
+		slog.InfoContext(serv.ctx, "updating registered model", "id", *registeredModel.Id)
 		existing, err = serv.GetRegisteredModelById(*registeredModel.Id)
 		if err != nil {
 			return nil, err
@@ -134,18 +156,25 @@ func (serv *ModelRegistryService) UpsertRegisteredModel(registeredModel *openapi
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
 		registeredModel = &withNotEditable
+		registeredModel.CustomProperties = mergeCustomProperties(registeredModel.CustomProperties, existing.GetCustomProperties())
 	}
 
 	modelCtx, err := serv.mapper.MapFromRegisteredModel(registeredModel)
 	if err != nil {
 		return nil, err
 	}
+	modelCtx.Name = serv.tenantName(modelCtx.Name)
+	modelCtx.Properties = serv.stampTenant(modelCtx.Properties)
 
-	modelCtxResp, err := serv.mlmdClient.PutContexts(context.Background(), &proto.PutContextsRequest{
+	request := &proto.PutContextsRequest{
 		Contexts: []*proto.Context{
 			modelCtx,
 		},
-	})
+	}
+	if existing != nil {
+		request.UpdateMask = updateMask(contextUpdatePaths, modelCtx.CustomProperties, registeredModel.GetCustomProperties(), existing.GetCustomProperties())
+	}
+	modelCtxResp, err := serv.mlmdClient.PutContexts(serv.ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -161,14 +190,17 @@ func (serv *ModelRegistryService) UpsertRegisteredModel(registeredModel *openapi
 
 // GetRegisteredModelById retrieves a registered model by its unique identifier (ID).
 func (serv *ModelRegistryService) GetRegisteredModelById(id string) (*openapi.RegisteredModel, error) {
-	glog.Infof("Getting registered model %s", id)
+	serv, span := serv.startSpan("GetRegisteredModelById")
+	defer span.End()
+
+	slog.DebugContext(serv.ctx, "getting registered model", "id", id)
 
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getByIdResp, err := serv.mlmdClient.GetContextsByID(context.Background(), &proto.GetContextsByIDRequest{
+	getByIdResp, err := serv.mlmdClient.GetContextsByID(serv.ctx, &proto.GetContextsByIDRequest{
 		ContextIds: []int64{int64(*idAsInt)},
 	})
 	if err != nil {
@@ -179,7 +211,7 @@ func (serv *ModelRegistryService) GetRegisteredModelById(id string) (*openapi.Re
 		return nil, fmt.Errorf("multiple registered models found for id %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getByIdResp.Contexts) == 0 {
+	if len(getByIdResp.Contexts) == 0 || !serv.ownedByTenant(getByIdResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no registered model found for id %s: %w", id, api.ErrNotFound)
 	}
 
@@ -187,12 +219,16 @@ func (serv *ModelRegistryService) GetRegisteredModelById(id string) (*openapi.Re
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	regModel.Name = serv.untenantName(regModel.Name)
 
 	return regModel, nil
 }
 
 // GetRegisteredModelByInferenceService retrieves a registered model associated with the specified inference service ID.
 func (serv *ModelRegistryService) GetRegisteredModelByInferenceService(inferenceServiceId string) (*openapi.RegisteredModel, error) {
+	serv, span := serv.startSpan("GetRegisteredModelByInferenceService")
+	defer span.End()
+
 	is, err := serv.GetInferenceServiceById(inferenceServiceId)
 	if err != nil {
 		return nil, err
@@ -202,14 +238,14 @@ func (serv *ModelRegistryService) GetRegisteredModelByInferenceService(inference
 
 // getRegisteredModelByVersionId retrieves a registered model associated with the specified model version ID.
 func (serv *ModelRegistryService) getRegisteredModelByVersionId(id string) (*openapi.RegisteredModel, error) {
-	glog.Infof("Getting registered model for model version %s", id)
+	slog.DebugContext(serv.ctx, "getting registered model for model version", "modelVersionId", id)
 
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getParentResp, err := serv.mlmdClient.GetParentContextsByContext(context.Background(), &proto.GetParentContextsByContextRequest{
+	getParentResp, err := serv.mlmdClient.GetParentContextsByContext(serv.ctx, &proto.GetParentContextsByContextRequest{
 		ContextId: idAsInt,
 	})
 	if err != nil {
@@ -220,7 +256,7 @@ func (serv *ModelRegistryService) getRegisteredModelByVersionId(id string) (*ope
 		return nil, fmt.Errorf("multiple registered models found for model version %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getParentResp.Contexts) == 0 {
+	if len(getParentResp.Contexts) == 0 || !serv.ownedByTenant(getParentResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no registered model found for model version %s: %w", id, api.ErrNotFound)
 	}
 
@@ -228,6 +264,7 @@ func (serv *ModelRegistryService) getRegisteredModelByVersionId(id string) (*ope
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	regModel.Name = serv.untenantName(regModel.Name)
 
 	return regModel, nil
 }
@@ -235,19 +272,23 @@ func (serv *ModelRegistryService) getRegisteredModelByVersionId(id string) (*ope
 // GetRegisteredModelByParams retrieves a registered model based on specified parameters, such as name or external ID.
 // If multiple or no registered models are found, an error is returned accordingly.
 func (serv *ModelRegistryService) GetRegisteredModelByParams(name *string, externalId *string) (*openapi.RegisteredModel, error) {
-	glog.Infof("Getting registered model by params name=%v, externalId=%v", name, externalId)
+	serv, span := serv.startSpan("GetRegisteredModelByParams")
+	defer span.End()
+
+	slog.DebugContext(serv.ctx, "getting registered model by params", "name", apiutils.ZeroIfNil(name), "externalId", apiutils.ZeroIfNil(externalId))
 
 	filterQuery := ""
 	if name != nil {
-		filterQuery = fmt.Sprintf("name = \"%s\"", *name)
+		filterQuery = fmt.Sprintf("name = \"%s\"", *serv.tenantName(name))
 	} else if externalId != nil {
 		filterQuery = fmt.Sprintf("external_id = \"%s\"", *externalId)
 	} else {
 		return nil, fmt.Errorf("invalid parameters call, supply either name or externalId: %w", api.ErrBadRequest)
 	}
-	glog.Info("filterQuery ", filterQuery)
+	filterQuery = serv.tenantQuery(filterQuery)
+	slog.DebugContext(serv.ctx, "querying MLMD", "filterQuery", filterQuery)
 
-	getByParamsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	getByParamsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.RegisteredModelTypeName,
 		Options: &proto.ListOperationOptions{
 			FilterQuery: &filterQuery,
@@ -269,16 +310,23 @@ func (serv *ModelRegistryService) GetRegisteredModelByParams(name *string, exter
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	regModel.Name = serv.untenantName(regModel.Name)
 	return regModel, nil
 }
 
 // GetRegisteredModels retrieves a list of registered models based on the provided list options.
 func (serv *ModelRegistryService) GetRegisteredModels(listOptions api.ListOptions) (*openapi.RegisteredModelList, error) {
+	serv, span := serv.startSpan("GetRegisteredModels")
+	defer span.End()
+
 	listOperationOptions, err := apiutils.BuildListOperationOptions(listOptions)
 	if err != nil {
 		return nil, err
 	}
-	contextsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	if query := serv.tenantQuery(""); query != "" {
+		listOperationOptions.FilterQuery = &query
+	}
+	contextsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.RegisteredModelTypeName,
 		Options:  listOperationOptions,
 	})
@@ -292,6 +340,7 @@ func (serv *ModelRegistryService) GetRegisteredModels(listOptions api.ListOption
 		if err != nil {
 			return nil, err
 		}
+		mapped.Name = serv.untenantName(mapped.Name)
 		results = append(results, *mapped)
 	}
 
@@ -309,13 +358,16 @@ func (serv *ModelRegistryService) GetRegisteredModels(listOptions api.ListOption
 // UpsertModelVersion creates a new model version if the provided model version's ID is nil,
 // or updates an existing model version if the ID is provided.
 func (serv *ModelRegistryService) UpsertModelVersion(modelVersion *openapi.ModelVersion, registeredModelId *string) (*openapi.ModelVersion, error) {
+	serv, span := serv.startSpan("UpsertModelVersion")
+	defer span.End()
+
 	var err error
 	var existing *openapi.ModelVersion
 	var registeredModel *openapi.RegisteredModel
 
 	if modelVersion.Id == nil {
 		// create
-		glog.Info("Creating new model version")
+		slog.InfoContext(serv.ctx, "creating new model version")
 		if registeredModelId == nil {
 			return nil, fmt.Errorf("missing registered model id, cannot create model version without registered model: %w", api.ErrBadRequest)
 		}
@@ -325,7 +377,7 @@ func (serv *ModelRegistryService) UpsertModelVersion(modelVersion *openapi.Model
 		}
 	} else {
 		// update
-		glog.Infof("Updating model version %s", *modelVersion.Id)
+		slog.InfoContext(serv.ctx, "updating model version", "id", *modelVersion.Id)
 		existing, err = serv.GetModelVersionById(*modelVersion.Id)
 		if err != nil {
 			return nil, err
@@ -336,6 +388,7 @@ func (serv *ModelRegistryService) UpsertModelVersion(modelVersion *openapi.Model
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
 		modelVersion = &withNotEditable
+		modelVersion.CustomProperties = mergeCustomProperties(modelVersion.CustomProperties, existing.GetCustomProperties())
 
 		registeredModel, err = serv.getRegisteredModelByVersionId(*modelVersion.Id)
 		if err != nil {
@@ -343,16 +396,25 @@ func (serv *ModelRegistryService) UpsertModelVersion(modelVersion *openapi.Model
 		}
 	}
 
+	if err := serv.checkCustomProperties("ModelVersion", registeredModel, modelVersion.GetCustomProperties(), existing.GetCustomProperties()); err != nil {
+		return nil, err
+	}
+
 	modelCtx, err := serv.mapper.MapFromModelVersion(modelVersion, *registeredModel.Id, registeredModel.Name)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	modelCtx.Properties = serv.stampTenant(modelCtx.Properties)
 
-	modelCtxResp, err := serv.mlmdClient.PutContexts(context.Background(), &proto.PutContextsRequest{
+	request := &proto.PutContextsRequest{
 		Contexts: []*proto.Context{
 			modelCtx,
 		},
-	})
+	}
+	if existing != nil {
+		request.UpdateMask = updateMask(contextUpdatePaths, modelCtx.CustomProperties, modelVersion.GetCustomProperties(), existing.GetCustomProperties())
+	}
+	modelCtxResp, err := serv.mlmdClient.PutContexts(serv.ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +426,7 @@ func (serv *ModelRegistryService) UpsertModelVersion(modelVersion *openapi.Model
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
 
-		_, err = serv.mlmdClient.PutParentContexts(context.Background(), &proto.PutParentContextsRequest{
+		_, err = serv.mlmdClient.PutParentContexts(serv.ctx, &proto.PutParentContextsRequest{
 			ParentContexts: []*proto.ParentContext{{
 				ChildId:  modelId,
 				ParentId: registeredModelId,
@@ -387,12 +449,15 @@ func (serv *ModelRegistryService) UpsertModelVersion(modelVersion *openapi.Model
 
 // GetModelVersionById retrieves a model version by its unique identifier (ID).
 func (serv *ModelRegistryService) GetModelVersionById(id string) (*openapi.ModelVersion, error) {
+	serv, span := serv.startSpan("GetModelVersionById")
+	defer span.End()
+
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getByIdResp, err := serv.mlmdClient.GetContextsByID(context.Background(), &proto.GetContextsByIDRequest{
+	getByIdResp, err := serv.mlmdClient.GetContextsByID(serv.ctx, &proto.GetContextsByIDRequest{
 		ContextIds: []int64{int64(*idAsInt)},
 	})
 	if err != nil {
@@ -403,7 +468,7 @@ func (serv *ModelRegistryService) GetModelVersionById(id string) (*openapi.Model
 		return nil, fmt.Errorf("multiple model versions found for id %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getByIdResp.Contexts) == 0 {
+	if len(getByIdResp.Contexts) == 0 || !serv.ownedByTenant(getByIdResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no model version found for id %s: %w", id, api.ErrNotFound)
 	}
 
@@ -417,6 +482,9 @@ func (serv *ModelRegistryService) GetModelVersionById(id string) (*openapi.Model
 
 // GetModelVersionByInferenceService retrieves the model version associated with the specified inference service ID.
 func (serv *ModelRegistryService) GetModelVersionByInferenceService(inferenceServiceId string) (*openapi.ModelVersion, error) {
+	serv, span := serv.startSpan("GetModelVersionByInferenceService")
+	defer span.End()
+
 	is, err := serv.GetInferenceServiceById(inferenceServiceId)
 	if err != nil {
 		return nil, err
@@ -439,14 +507,14 @@ func (serv *ModelRegistryService) GetModelVersionByInferenceService(inferenceSer
 
 // getModelVersionByArtifactId retrieves the model version associated with the specified model artifact ID.
 func (serv *ModelRegistryService) getModelVersionByArtifactId(id string) (*openapi.ModelVersion, error) {
-	glog.Infof("Getting model version for model artifact %s", id)
+	slog.DebugContext(serv.ctx, "getting model version for model artifact", "modelArtifactId", id)
 
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getParentResp, err := serv.mlmdClient.GetContextsByArtifact(context.Background(), &proto.GetContextsByArtifactRequest{
+	getParentResp, err := serv.mlmdClient.GetContextsByArtifact(serv.ctx, &proto.GetContextsByArtifactRequest{
 		ArtifactId: idAsInt,
 	})
 	if err != nil {
@@ -457,7 +525,7 @@ func (serv *ModelRegistryService) getModelVersionByArtifactId(id string) (*opena
 		return nil, fmt.Errorf("multiple model versions found for artifact %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getParentResp.Contexts) == 0 {
+	if len(getParentResp.Contexts) == 0 || !serv.ownedByTenant(getParentResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no model version found for artifact %s: %w", id, api.ErrNotFound)
 	}
 
@@ -472,6 +540,9 @@ func (serv *ModelRegistryService) getModelVersionByArtifactId(id string) (*opena
 // GetModelVersionByParams retrieves a model version based on specified parameters, such as (version name and registered model ID), or external ID.
 // If multiple or no model versions are found, an error is returned.
 func (serv *ModelRegistryService) GetModelVersionByParams(versionName *string, registeredModelId *string, externalId *string) (*openapi.ModelVersion, error) {
+	serv, span := serv.startSpan("GetModelVersionByParams")
+	defer span.End()
+
 	filterQuery := ""
 	if versionName != nil && registeredModelId != nil {
 		filterQuery = fmt.Sprintf("name = \"%s\"", converter.PrefixWhenOwned(registeredModelId, *versionName))
@@ -480,8 +551,9 @@ func (serv *ModelRegistryService) GetModelVersionByParams(versionName *string, r
 	} else {
 		return nil, fmt.Errorf("invalid parameters call, supply either (versionName and registeredModelId), or externalId: %w", api.ErrBadRequest)
 	}
+	filterQuery = serv.tenantQuery(filterQuery)
 
-	getByParamsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	getByParamsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.ModelVersionTypeName,
 		Options: &proto.ListOperationOptions{
 			FilterQuery: &filterQuery,
@@ -508,17 +580,23 @@ func (serv *ModelRegistryService) GetModelVersionByParams(versionName *string, r
 
 // GetModelVersions retrieves a list of model versions based on the provided list options and optional registered model ID.
 func (serv *ModelRegistryService) GetModelVersions(listOptions api.ListOptions, registeredModelId *string) (*openapi.ModelVersionList, error) {
+	serv, span := serv.startSpan("GetModelVersions")
+	defer span.End()
+
 	listOperationOptions, err := apiutils.BuildListOperationOptions(listOptions)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
+	query := ""
 	if registeredModelId != nil {
-		queryParentCtxId := fmt.Sprintf("parent_contexts_a.id = %s", *registeredModelId)
-		listOperationOptions.FilterQuery = &queryParentCtxId
+		query = fmt.Sprintf("parent_contexts_a.id = %s", *registeredModelId)
+	}
+	if query = serv.tenantQuery(query); query != "" {
+		listOperationOptions.FilterQuery = &query
 	}
 
-	contextsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	contextsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.ModelVersionTypeName,
 		Options:  listOperationOptions,
 	})
@@ -551,14 +629,18 @@ func (serv *ModelRegistryService) GetModelVersions(listOptions api.ListOptions,
 // A model version ID must be provided to disambiguate between artifacts.
 // Upon creation, new artifacts will be associated with their corresponding model version.
 func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, modelVersionId *string) (*openapi.Artifact, error) {
+	serv, span := serv.startSpan("UpsertArtifact")
+	defer span.End()
+
 	if artifact == nil {
 		return nil, fmt.Errorf("invalid artifact pointer, can't upsert nil")
 	}
 	creating := false
+	var existingCustomProperties map[string]openapi.MetadataValue
 	if ma := artifact.ModelArtifact; ma != nil {
 		if ma.Id == nil {
 			creating = true
-			glog.Info("Creating model artifact")
+			slog.InfoContext(serv.ctx, "creating model artifact")
 			if modelVersionId == nil {
 				return nil, fmt.Errorf("missing model version id, cannot create artifact without model version: %w", api.ErrBadRequest)
 			}
@@ -566,8 +648,14 @@ func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, mod
 			if err != nil {
 				return nil, fmt.Errorf("no model version found for id %s: %w", *modelVersionId, api.ErrNotFound)
 			}
+			if err := serv.checkModelArtifactCustomProperties(ma, nil, *modelVersionId); err != nil {
+				return nil, err
+			}
+			if err := serv.checkDigests(ma, nil); err != nil {
+				return nil, err
+			}
 		} else {
-			glog.Info("Updating model artifact")
+			slog.InfoContext(serv.ctx, "updating model artifact", "id", *ma.Id)
 			existing, err := serv.GetModelArtifactById(*ma.Id)
 			if err != nil {
 				return nil, err
@@ -578,16 +666,25 @@ func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, mod
 				return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 			}
 			ma = &withNotEditable
+			ma.CustomProperties = mergeCustomProperties(ma.CustomProperties, existing.GetCustomProperties())
+			artifact = &openapi.Artifact{ModelArtifact: ma}
+			existingCustomProperties = existing.GetCustomProperties()
 
-			_, err = serv.getModelVersionByArtifactId(*ma.Id)
+			modelVersion, err := serv.getModelVersionByArtifactId(*ma.Id)
 			if err != nil {
 				return nil, err
 			}
+			if err := serv.checkModelArtifactCustomProperties(ma, existing, *modelVersion.Id); err != nil {
+				return nil, err
+			}
+			if err := serv.checkDigests(ma, existing); err != nil {
+				return nil, err
+			}
 		}
 	} else if da := artifact.DocArtifact; da != nil {
 		if da.Id == nil {
 			creating = true
-			glog.Info("Creating doc artifact")
+			slog.InfoContext(serv.ctx, "creating doc artifact")
 			if modelVersionId == nil {
 				return nil, fmt.Errorf("missing model version id, cannot create artifact without model version: %w", api.ErrBadRequest)
 			}
@@ -596,7 +693,7 @@ func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, mod
 				return nil, fmt.Errorf("no model version found for id %s: %w", *modelVersionId, api.ErrNotFound)
 			}
 		} else {
-			glog.Info("Updating doc artifact")
+			slog.InfoContext(serv.ctx, "updating doc artifact", "id", *da.Id)
 			existing, err := serv.GetArtifactById(*da.Id)
 			if err != nil {
 				return nil, err
@@ -610,6 +707,9 @@ func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, mod
 				return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 			}
 			da = &withNotEditable
+			da.CustomProperties = mergeCustomProperties(da.CustomProperties, existing.DocArtifact.GetCustomProperties())
+			artifact = &openapi.Artifact{DocArtifact: da}
+			existingCustomProperties = existing.DocArtifact.GetCustomProperties()
 
 			_, err = serv.getModelVersionByArtifactId(*da.Id)
 			if err != nil {
@@ -623,9 +723,14 @@ func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, mod
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
-	artifactsResp, err := serv.mlmdClient.PutArtifacts(context.Background(), &proto.PutArtifactsRequest{
+	pa.Properties = serv.stampTenant(pa.Properties)
+	request := &proto.PutArtifactsRequest{
 		Artifacts: []*proto.Artifact{pa},
-	})
+	}
+	if !creating {
+		request.UpdateMask = updateMask(artifactUpdatePaths, pa.CustomProperties, artifactCustomProperties(artifact), existingCustomProperties)
+	}
+	artifactsResp, err := serv.mlmdClient.PutArtifacts(serv.ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -643,7 +748,7 @@ func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, mod
 				ArtifactId: &a,
 			})
 		}
-		_, err = serv.mlmdClient.PutAttributionsAndAssociations(context.Background(), &proto.PutAttributionsAndAssociationsRequest{
+		_, err = serv.mlmdClient.PutAttributionsAndAssociations(serv.ctx, &proto.PutAttributionsAndAssociationsRequest{
 			Attributions: attributions,
 			Associations: make([]*proto.Association, 0),
 		})
@@ -657,12 +762,15 @@ func (serv *ModelRegistryService) UpsertArtifact(artifact *openapi.Artifact, mod
 }
 
 func (serv *ModelRegistryService) GetArtifactById(id string) (*openapi.Artifact, error) {
+	serv, span := serv.startSpan("GetArtifactById")
+	defer span.End()
+
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	artifactsResp, err := serv.mlmdClient.GetArtifactsByID(context.Background(), &proto.GetArtifactsByIDRequest{
+	artifactsResp, err := serv.mlmdClient.GetArtifactsByID(serv.ctx, &proto.GetArtifactsByIDRequest{
 		ArtifactIds: []int64{int64(*idAsInt)},
 	})
 	if err != nil {
@@ -671,13 +779,16 @@ func (serv *ModelRegistryService) GetArtifactById(id string) (*openapi.Artifact,
 	if len(artifactsResp.Artifacts) > 1 {
 		return nil, fmt.Errorf("multiple artifacts found for id %s: %w", id, api.ErrNotFound)
 	}
-	if len(artifactsResp.Artifacts) == 0 {
+	if len(artifactsResp.Artifacts) == 0 || !serv.ownedByTenant(artifactsResp.Artifacts[0].Properties) {
 		return nil, fmt.Errorf("no artifact found for id %s: %w", id, api.ErrNotFound)
 	}
 	return serv.mapper.MapToArtifact(artifactsResp.Artifacts[0])
 }
 
 func (serv *ModelRegistryService) GetArtifacts(listOptions api.ListOptions, modelVersionId *string) (*openapi.ArtifactList, error) {
+	serv, span := serv.startSpan("GetArtifacts")
+	defer span.End()
+
 	listOperationOptions, err := apiutils.BuildListOperationOptions(listOptions)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
@@ -691,7 +802,13 @@ func (serv *ModelRegistryService) GetArtifacts(listOptions api.ListOptions, mode
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
-	artifactsResp, err := serv.mlmdClient.GetArtifactsByContext(context.Background(), &proto.GetArtifactsByContextRequest{
+	if serv.tenant != "" {
+		// artifacts are listed by context, make sure the model version belongs to the tenant
+		if _, err := serv.GetModelVersionById(*modelVersionId); err != nil {
+			return nil, err
+		}
+	}
+	artifactsResp, err := serv.mlmdClient.GetArtifactsByContext(serv.ctx, &proto.GetArtifactsByContextRequest{
 		ContextId: ctxId,
 		Options:   listOperationOptions,
 	})
@@ -726,6 +843,9 @@ func (serv *ModelRegistryService) GetArtifacts(listOptions api.ListOptions, mode
 // If a model version ID is provided and the model artifact is newly created, establishes an
 // explicit attribution between the model version and the created model artifact.
 func (serv *ModelRegistryService) UpsertModelArtifact(modelArtifact *openapi.ModelArtifact, modelVersionId *string) (*openapi.ModelArtifact, error) {
+	serv, span := serv.startSpan("UpsertModelArtifact")
+	defer span.End()
+
 	art, err := serv.UpsertArtifact(&openapi.Artifact{
 		ModelArtifact: modelArtifact,
 	}, modelVersionId)
@@ -737,6 +857,9 @@ func (serv *ModelRegistryService) UpsertModelArtifact(modelArtifact *openapi.Mod
 
 // GetModelArtifactById retrieves a model artifact by its unique identifier (ID).
 func (serv *ModelRegistryService) GetModelArtifactById(id string) (*openapi.ModelArtifact, error) {
+	serv, span := serv.startSpan("GetModelArtifactById")
+	defer span.End()
+
 	art, err := serv.GetArtifactById(id)
 	if err != nil {
 		return nil, err
@@ -750,6 +873,9 @@ func (serv *ModelRegistryService) GetModelArtifactById(id string) (*openapi.Mode
 
 // GetModelArtifactByInferenceService retrieves the model artifact associated with the specified inference service ID.
 func (serv *ModelRegistryService) GetModelArtifactByInferenceService(inferenceServiceId string) (*openapi.ModelArtifact, error) {
+	serv, span := serv.startSpan("GetModelArtifactByInferenceService")
+	defer span.End()
+
 	mv, err := serv.GetModelVersionByInferenceService(inferenceServiceId)
 	if err != nil {
 		return nil, err
@@ -770,6 +896,9 @@ func (serv *ModelRegistryService) GetModelArtifactByInferenceService(inferenceSe
 // GetModelArtifactByParams retrieves a model artifact based on specified parameters, such as (artifact name and model version ID), or external ID.
 // If multiple or no model artifacts are found, an error is returned.
 func (serv *ModelRegistryService) GetModelArtifactByParams(artifactName *string, modelVersionId *string, externalId *string) (*openapi.ModelArtifact, error) {
+	serv, span := serv.startSpan("GetModelArtifactByParams")
+	defer span.End()
+
 	var artifact0 *proto.Artifact
 
 	filterQuery := ""
@@ -780,9 +909,10 @@ func (serv *ModelRegistryService) GetModelArtifactByParams(artifactName *string,
 	} else {
 		return nil, fmt.Errorf("invalid parameters call, supply either (artifactName and modelVersionId), or externalId: %w", api.ErrBadRequest)
 	}
-	glog.Info("filterQuery ", filterQuery)
+	filterQuery = serv.tenantQuery(filterQuery)
+	slog.DebugContext(serv.ctx, "querying MLMD", "filterQuery", filterQuery)
 
-	artifactsResponse, err := serv.mlmdClient.GetArtifactsByType(context.Background(), &proto.GetArtifactsByTypeRequest{
+	artifactsResponse, err := serv.mlmdClient.GetArtifactsByType(serv.ctx, &proto.GetArtifactsByTypeRequest{
 		TypeName: &serv.nameConfig.ModelArtifactTypeName,
 		Options: &proto.ListOperationOptions{
 			FilterQuery: &filterQuery,
@@ -812,6 +942,9 @@ func (serv *ModelRegistryService) GetModelArtifactByParams(artifactName *string,
 
 // GetModelArtifacts retrieves a list of model artifacts based on the provided list options and optional model version ID.
 func (serv *ModelRegistryService) GetModelArtifacts(listOptions api.ListOptions, modelVersionId *string) (*openapi.ModelArtifactList, error) {
+	serv, span := serv.startSpan("GetModelArtifacts")
+	defer span.End()
+
 	listOperationOptions, err := apiutils.BuildListOperationOptions(listOptions)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
@@ -824,7 +957,13 @@ func (serv *ModelRegistryService) GetModelArtifacts(listOptions api.ListOptions,
 		if err != nil {
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
-		artifactsResp, err := serv.mlmdClient.GetArtifactsByContext(context.Background(), &proto.GetArtifactsByContextRequest{
+		if serv.tenant != "" {
+			// artifacts are listed by context, make sure the model version belongs to the tenant
+			if _, err := serv.GetModelVersionById(*modelVersionId); err != nil {
+				return nil, err
+			}
+		}
+		artifactsResp, err := serv.mlmdClient.GetArtifactsByContext(serv.ctx, &proto.GetArtifactsByContextRequest{
 			ContextId: ctxId,
 			Options:   listOperationOptions,
 		})
@@ -834,7 +973,10 @@ func (serv *ModelRegistryService) GetModelArtifacts(listOptions api.ListOptions,
 		artifacts = artifactsResp.Artifacts
 		nextPageToken = artifactsResp.NextPageToken
 	} else {
-		artifactsResp, err := serv.mlmdClient.GetArtifactsByType(context.Background(), &proto.GetArtifactsByTypeRequest{
+		if query := serv.tenantQuery(""); query != "" {
+			listOperationOptions.FilterQuery = &query
+		}
+		artifactsResp, err := serv.mlmdClient.GetArtifactsByType(serv.ctx, &proto.GetArtifactsByTypeRequest{
 			TypeName: &serv.nameConfig.ModelArtifactTypeName,
 			Options:  listOperationOptions,
 		})
@@ -868,13 +1010,16 @@ func (serv *ModelRegistryService) GetModelArtifacts(listOptions api.ListOptions,
 // UpsertServingEnvironment creates a new serving environment if the provided serving environment's ID is nil,
 // or updates an existing serving environment if the ID is provided.
 func (serv *ModelRegistryService) UpsertServingEnvironment(servingEnvironment *openapi.ServingEnvironment) (*openapi.ServingEnvironment, error) {
+	serv, span := serv.startSpan("UpsertServingEnvironment")
+	defer span.End()
+
 	var err error
 	var existing *openapi.ServingEnvironment
 
 	if servingEnvironment.Id == nil {
-		glog.Info("Creating new serving environment")
+		slog.InfoContext(serv.ctx, "creating new serving environment")
 	} else {
-		glog.Infof("Updating serving environment %s", *servingEnvironment.Id)
+		slog.InfoContext(serv.ctx, "updating serving environment", "id", *servingEnvironment.Id)
 		existing, err = serv.GetServingEnvironmentById(*servingEnvironment.Id)
 		if err != nil {
 			return nil, err
@@ -885,18 +1030,25 @@ func (serv *ModelRegistryService) UpsertServingEnvironment(servingEnvironment *o
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
 		servingEnvironment = &withNotEditable
+		servingEnvironment.CustomProperties = mergeCustomProperties(servingEnvironment.CustomProperties, existing.GetCustomProperties())
 	}
 
 	protoCtx, err := serv.mapper.MapFromServingEnvironment(servingEnvironment)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	protoCtx.Name = serv.tenantName(protoCtx.Name)
+	protoCtx.Properties = serv.stampTenant(protoCtx.Properties)
 
-	protoCtxResp, err := serv.mlmdClient.PutContexts(context.Background(), &proto.PutContextsRequest{
+	request := &proto.PutContextsRequest{
 		Contexts: []*proto.Context{
 			protoCtx,
 		},
-	})
+	}
+	if existing != nil {
+		request.UpdateMask = updateMask(contextUpdatePaths, protoCtx.CustomProperties, servingEnvironment.GetCustomProperties(), existing.GetCustomProperties())
+	}
+	protoCtxResp, err := serv.mlmdClient.PutContexts(serv.ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -912,14 +1064,17 @@ func (serv *ModelRegistryService) UpsertServingEnvironment(servingEnvironment *o
 
 // GetServingEnvironmentById retrieves a serving environment by its unique identifier (ID).
 func (serv *ModelRegistryService) GetServingEnvironmentById(id string) (*openapi.ServingEnvironment, error) {
-	glog.Infof("Getting serving environment %s", id)
+	serv, span := serv.startSpan("GetServingEnvironmentById")
+	defer span.End()
+
+	slog.DebugContext(serv.ctx, "getting serving environment", "id", id)
 
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getByIdResp, err := serv.mlmdClient.GetContextsByID(context.Background(), &proto.GetContextsByIDRequest{
+	getByIdResp, err := serv.mlmdClient.GetContextsByID(serv.ctx, &proto.GetContextsByIDRequest{
 		ContextIds: []int64{*idAsInt},
 	})
 	if err != nil {
@@ -930,7 +1085,7 @@ func (serv *ModelRegistryService) GetServingEnvironmentById(id string) (*openapi
 		return nil, fmt.Errorf("multiple serving environments found for id %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getByIdResp.Contexts) == 0 {
+	if len(getByIdResp.Contexts) == 0 || !serv.ownedByTenant(getByIdResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no serving environment found for id %s: %w", id, api.ErrNotFound)
 	}
 
@@ -938,6 +1093,7 @@ func (serv *ModelRegistryService) GetServingEnvironmentById(id string) (*openapi
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	openapiModel.Name = serv.untenantName(openapiModel.Name)
 
 	return openapiModel, nil
 }
@@ -945,18 +1101,22 @@ func (serv *ModelRegistryService) GetServingEnvironmentById(id string) (*openapi
 // GetServingEnvironmentByParams retrieves a serving environment based on specified parameters, such as name or external ID.
 // If multiple or no serving environments are found, an error is returned accordingly.
 func (serv *ModelRegistryService) GetServingEnvironmentByParams(name *string, externalId *string) (*openapi.ServingEnvironment, error) {
-	glog.Infof("Getting serving environment by params name=%v, externalId=%v", name, externalId)
+	serv, span := serv.startSpan("GetServingEnvironmentByParams")
+	defer span.End()
+
+	slog.DebugContext(serv.ctx, "getting serving environment by params", "name", apiutils.ZeroIfNil(name), "externalId", apiutils.ZeroIfNil(externalId))
 
 	filterQuery := ""
 	if name != nil {
-		filterQuery = fmt.Sprintf("name = \"%s\"", *name)
+		filterQuery = fmt.Sprintf("name = \"%s\"", *serv.tenantName(name))
 	} else if externalId != nil {
 		filterQuery = fmt.Sprintf("external_id = \"%s\"", *externalId)
 	} else {
 		return nil, fmt.Errorf("invalid parameters call, supply either name or externalId: %w", api.ErrBadRequest)
 	}
+	filterQuery = serv.tenantQuery(filterQuery)
 
-	getByParamsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	getByParamsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.ServingEnvironmentTypeName,
 		Options: &proto.ListOperationOptions{
 			FilterQuery: &filterQuery,
@@ -978,16 +1138,23 @@ func (serv *ModelRegistryService) GetServingEnvironmentByParams(name *string, ex
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	openapiModel.Name = serv.untenantName(openapiModel.Name)
 	return openapiModel, nil
 }
 
 // GetServingEnvironments retrieves a list of serving environments based on the provided list options.
 func (serv *ModelRegistryService) GetServingEnvironments(listOptions api.ListOptions) (*openapi.ServingEnvironmentList, error) {
+	serv, span := serv.startSpan("GetServingEnvironments")
+	defer span.End()
+
 	listOperationOptions, err := apiutils.BuildListOperationOptions(listOptions)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
-	contextsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	if query := serv.tenantQuery(""); query != "" {
+		listOperationOptions.FilterQuery = &query
+	}
+	contextsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.ServingEnvironmentTypeName,
 		Options:  listOperationOptions,
 	})
@@ -1001,6 +1168,7 @@ func (serv *ModelRegistryService) GetServingEnvironments(listOptions api.ListOpt
 		if err != nil {
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
+		mapped.Name = serv.untenantName(mapped.Name)
 		results = append(results, *mapped)
 	}
 
@@ -1018,20 +1186,23 @@ func (serv *ModelRegistryService) GetServingEnvironments(listOptions api.ListOpt
 // UpsertInferenceService creates a new inference service if the provided inference service's ID is nil,
 // or updates an existing inference service if the ID is provided.
 func (serv *ModelRegistryService) UpsertInferenceService(inferenceService *openapi.InferenceService) (*openapi.InferenceService, error) {
+	serv, span := serv.startSpan("UpsertInferenceService")
+	defer span.End()
+
 	var err error
 	var existing *openapi.InferenceService
 	var servingEnvironment *openapi.ServingEnvironment
 
 	if inferenceService.Id == nil {
 		// create
-		glog.Info("Creating new InferenceService")
+		slog.InfoContext(serv.ctx, "creating new inference service")
 		servingEnvironment, err = serv.GetServingEnvironmentById(inferenceService.ServingEnvironmentId)
 		if err != nil {
 			return nil, err
 		}
 	} else {
 		// update
-		glog.Infof("Updating InferenceService %s", *inferenceService.Id)
+		slog.InfoContext(serv.ctx, "updating inference service", "id", *inferenceService.Id)
 
 		existing, err = serv.GetInferenceServiceById(*inferenceService.Id)
 		if err != nil {
@@ -1043,6 +1214,7 @@ func (serv *ModelRegistryService) UpsertInferenceService(inferenceService *opena
 			return nil, err
 		}
 		inferenceService = &withNotEditable
+		inferenceService.CustomProperties = mergeCustomProperties(inferenceService.CustomProperties, existing.GetCustomProperties())
 
 		servingEnvironment, err = serv.getServingEnvironmentByInferenceServiceId(*inferenceService.Id)
 		if err != nil {
@@ -1066,12 +1238,17 @@ func (serv *ModelRegistryService) UpsertInferenceService(inferenceService *opena
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	protoCtx.Properties = serv.stampTenant(protoCtx.Properties)
 
-	protoCtxResp, err := serv.mlmdClient.PutContexts(context.Background(), &proto.PutContextsRequest{
+	request := &proto.PutContextsRequest{
 		Contexts: []*proto.Context{
 			protoCtx,
 		},
-	})
+	}
+	if existing != nil {
+		request.UpdateMask = updateMask(contextUpdatePaths, protoCtx.CustomProperties, inferenceService.GetCustomProperties(), existing.GetCustomProperties())
+	}
+	protoCtxResp, err := serv.mlmdClient.PutContexts(serv.ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -1083,7 +1260,7 @@ func (serv *ModelRegistryService) UpsertInferenceService(inferenceService *opena
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
 
-		_, err = serv.mlmdClient.PutParentContexts(context.Background(), &proto.PutParentContextsRequest{
+		_, err = serv.mlmdClient.PutParentContexts(serv.ctx, &proto.PutParentContextsRequest{
 			ParentContexts: []*proto.ParentContext{{
 				ChildId:  inferenceServiceId,
 				ParentId: servingEnvironmentId,
@@ -1106,14 +1283,14 @@ func (serv *ModelRegistryService) UpsertInferenceService(inferenceService *opena
 
 // getServingEnvironmentByInferenceServiceId retrieves the serving environment associated with the specified inference service ID.
 func (serv *ModelRegistryService) getServingEnvironmentByInferenceServiceId(id string) (*openapi.ServingEnvironment, error) {
-	glog.Infof("Getting ServingEnvironment for InferenceService %s", id)
+	slog.DebugContext(serv.ctx, "getting serving environment for inference service", "inferenceServiceId", id)
 
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getParentResp, err := serv.mlmdClient.GetParentContextsByContext(context.Background(), &proto.GetParentContextsByContextRequest{
+	getParentResp, err := serv.mlmdClient.GetParentContextsByContext(serv.ctx, &proto.GetParentContextsByContextRequest{
 		ContextId: idAsInt,
 	})
 	if err != nil {
@@ -1124,7 +1301,7 @@ func (serv *ModelRegistryService) getServingEnvironmentByInferenceServiceId(id s
 		return nil, fmt.Errorf("multiple ServingEnvironments found for InferenceService %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getParentResp.Contexts) == 0 {
+	if len(getParentResp.Contexts) == 0 || !serv.ownedByTenant(getParentResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no ServingEnvironments found for InferenceService %s: %w", id, api.ErrNotFound)
 	}
 
@@ -1132,20 +1309,24 @@ func (serv *ModelRegistryService) getServingEnvironmentByInferenceServiceId(id s
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	toReturn.Name = serv.untenantName(toReturn.Name)
 
 	return toReturn, nil
 }
 
 // GetInferenceServiceById retrieves an inference service by its unique identifier (ID).
 func (serv *ModelRegistryService) GetInferenceServiceById(id string) (*openapi.InferenceService, error) {
-	glog.Infof("Getting InferenceService by id %s", id)
+	serv, span := serv.startSpan("GetInferenceServiceById")
+	defer span.End()
+
+	slog.DebugContext(serv.ctx, "getting inference service", "id", id)
 
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getByIdResp, err := serv.mlmdClient.GetContextsByID(context.Background(), &proto.GetContextsByIDRequest{
+	getByIdResp, err := serv.mlmdClient.GetContextsByID(serv.ctx, &proto.GetContextsByIDRequest{
 		ContextIds: []int64{*idAsInt},
 	})
 	if err != nil {
@@ -1156,7 +1337,7 @@ func (serv *ModelRegistryService) GetInferenceServiceById(id string) (*openapi.I
 		return nil, fmt.Errorf("multiple InferenceServices found for id %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getByIdResp.Contexts) == 0 {
+	if len(getByIdResp.Contexts) == 0 || !serv.ownedByTenant(getByIdResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no InferenceService found for id %s: %w", id, api.ErrNotFound)
 	}
 
@@ -1171,6 +1352,9 @@ func (serv *ModelRegistryService) GetInferenceServiceById(id string) (*openapi.I
 // GetInferenceServiceByParams retrieves an inference service based on specified parameters, such as (name and serving environment ID), or external ID.
 // If multiple or no serving environments are found, an error is returned accordingly.
 func (serv *ModelRegistryService) GetInferenceServiceByParams(name *string, servingEnvironmentId *string, externalId *string) (*openapi.InferenceService, error) {
+	serv, span := serv.startSpan("GetInferenceServiceByParams")
+	defer span.End()
+
 	filterQuery := ""
 	if name != nil && servingEnvironmentId != nil {
 		filterQuery = fmt.Sprintf("name = \"%s\"", converter.PrefixWhenOwned(servingEnvironmentId, *name))
@@ -1179,8 +1363,9 @@ func (serv *ModelRegistryService) GetInferenceServiceByParams(name *string, serv
 	} else {
 		return nil, fmt.Errorf("invalid parameters call, supply either (name and servingEnvironmentId), or externalId: %w", api.ErrBadRequest)
 	}
+	filterQuery = serv.tenantQuery(filterQuery)
 
-	getByParamsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	getByParamsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.InferenceServiceTypeName,
 		Options: &proto.ListOperationOptions{
 			FilterQuery: &filterQuery,
@@ -1207,6 +1392,9 @@ func (serv *ModelRegistryService) GetInferenceServiceByParams(name *string, serv
 
 // GetInferenceServices retrieves a list of inference services based on the provided list options and optional serving environment ID and runtime.
 func (serv *ModelRegistryService) GetInferenceServices(listOptions api.ListOptions, servingEnvironmentId *string, runtime *string) (*openapi.InferenceServiceList, error) {
+	serv, span := serv.startSpan("GetInferenceServices")
+	defer span.End()
+
 	listOperationOptions, err := apiutils.BuildListOperationOptions(listOptions)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
@@ -1223,10 +1411,10 @@ func (serv *ModelRegistryService) GetInferenceServices(listOptions api.ListOptio
 		queries = append(queries, queryRuntimeProp)
 	}
 
-	query := strings.Join(queries, " and ")
+	query := serv.tenantQuery(strings.Join(queries, " and "))
 	listOperationOptions.FilterQuery = &query
 
-	contextsResp, err := serv.mlmdClient.GetContextsByType(context.Background(), &proto.GetContextsByTypeRequest{
+	contextsResp, err := serv.mlmdClient.GetContextsByType(serv.ctx, &proto.GetContextsByTypeRequest{
 		TypeName: &serv.nameConfig.InferenceServiceTypeName,
 		Options:  listOperationOptions,
 	})
@@ -1257,12 +1445,15 @@ func (serv *ModelRegistryService) GetInferenceServices(listOptions api.ListOptio
 // UpsertServeModel creates a new serve model if the provided serve model's ID is nil,
 // or updates an existing serve model if the ID is provided.
 func (serv *ModelRegistryService) UpsertServeModel(serveModel *openapi.ServeModel, inferenceServiceId *string) (*openapi.ServeModel, error) {
+	serv, span := serv.startSpan("UpsertServeModel")
+	defer span.End()
+
 	var err error
 	var existing *openapi.ServeModel
 
 	if serveModel.Id == nil {
 		// create
-		glog.Info("Creating new ServeModel")
+		slog.InfoContext(serv.ctx, "creating new serve model")
 		if inferenceServiceId == nil {
 			return nil, fmt.Errorf("missing inferenceServiceId, cannot create ServeModel without parent resource InferenceService: %w", api.ErrBadRequest)
 		}
@@ -1272,7 +1463,7 @@ func (serv *ModelRegistryService) UpsertServeModel(serveModel *openapi.ServeMode
 		}
 	} else {
 		// update
-		glog.Infof("Updating ServeModel %s", *serveModel.Id)
+		slog.InfoContext(serv.ctx, "updating serve model", "id", *serveModel.Id)
 
 		existing, err = serv.GetServeModelById(*serveModel.Id)
 		if err != nil {
@@ -1284,11 +1475,17 @@ func (serv *ModelRegistryService) UpsertServeModel(serveModel *openapi.ServeMode
 			return nil, err
 		}
 		serveModel = &withNotEditable
+		serveModel.CustomProperties = mergeCustomProperties(serveModel.CustomProperties, existing.GetCustomProperties())
 
-		_, err = serv.getInferenceServiceByServeModel(*serveModel.Id)
+		inferenceService, err := serv.getInferenceServiceByServeModel(*serveModel.Id)
 		if err != nil {
 			return nil, err
 		}
+		if inferenceServiceId == nil {
+			inferenceServiceId = inferenceService.Id
+		} else if *inferenceServiceId != inferenceService.GetId() {
+			return nil, fmt.Errorf("no ServeModel found for id %s in InferenceService %s: %w", *serveModel.Id, *inferenceServiceId, api.ErrNotFound)
+		}
 	}
 	_, err = serv.GetModelVersionById(serveModel.ModelVersionId)
 	if err != nil {
@@ -1306,10 +1503,15 @@ func (serv *ModelRegistryService) UpsertServeModel(serveModel *openapi.ServeMode
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
+	execution.Properties = serv.stampTenant(execution.Properties)
 
-	executionsResp, err := serv.mlmdClient.PutExecutions(context.Background(), &proto.PutExecutionsRequest{
+	request := &proto.PutExecutionsRequest{
 		Executions: []*proto.Execution{execution},
-	})
+	}
+	if existing != nil {
+		request.UpdateMask = updateMask(executionUpdatePaths, execution.CustomProperties, serveModel.GetCustomProperties(), existing.GetCustomProperties())
+	}
+	executionsResp, err := serv.mlmdClient.PutExecutions(serv.ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -1327,7 +1529,7 @@ func (serv *ModelRegistryService) UpsertServeModel(serveModel *openapi.ServeMode
 				ExecutionId: &a,
 			})
 		}
-		_, err = serv.mlmdClient.PutAttributionsAndAssociations(context.Background(), &proto.PutAttributionsAndAssociationsRequest{
+		_, err = serv.mlmdClient.PutAttributionsAndAssociations(serv.ctx, &proto.PutAttributionsAndAssociationsRequest{
 			Attributions: make([]*proto.Attribution, 0),
 			Associations: associations,
 		})
@@ -1346,14 +1548,14 @@ func (serv *ModelRegistryService) UpsertServeModel(serveModel *openapi.ServeMode
 
 // getInferenceServiceByServeModel retrieves the inference service associated with the specified serve model ID.
 func (serv *ModelRegistryService) getInferenceServiceByServeModel(id string) (*openapi.InferenceService, error) {
-	glog.Infof("Getting InferenceService for ServeModel %s", id)
+	slog.DebugContext(serv.ctx, "getting inference service for serve model", "serveModelId", id)
 
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	getParentResp, err := serv.mlmdClient.GetContextsByExecution(context.Background(), &proto.GetContextsByExecutionRequest{
+	getParentResp, err := serv.mlmdClient.GetContextsByExecution(serv.ctx, &proto.GetContextsByExecutionRequest{
 		ExecutionId: idAsInt,
 	})
 	if err != nil {
@@ -1364,7 +1566,7 @@ func (serv *ModelRegistryService) getInferenceServiceByServeModel(id string) (*o
 		return nil, fmt.Errorf("multiple InferenceService found for ServeModel %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(getParentResp.Contexts) == 0 {
+	if len(getParentResp.Contexts) == 0 || !serv.ownedByTenant(getParentResp.Contexts[0].Properties) {
 		return nil, fmt.Errorf("no InferenceService found for ServeModel %s: %w", id, api.ErrNotFound)
 	}
 
@@ -1378,12 +1580,15 @@ func (serv *ModelRegistryService) getInferenceServiceByServeModel(id string) (*o
 
 // GetServeModelById retrieves a serve model by its unique identifier (ID).
 func (serv *ModelRegistryService) GetServeModelById(id string) (*openapi.ServeModel, error) {
+	serv, span := serv.startSpan("GetServeModelById")
+	defer span.End()
+
 	idAsInt, err := converter.StringToInt64(&id)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
-	executionsResp, err := serv.mlmdClient.GetExecutionsByID(context.Background(), &proto.GetExecutionsByIDRequest{
+	executionsResp, err := serv.mlmdClient.GetExecutionsByID(serv.ctx, &proto.GetExecutionsByIDRequest{
 		ExecutionIds: []int64{int64(*idAsInt)},
 	})
 	if err != nil {
@@ -1394,7 +1599,7 @@ func (serv *ModelRegistryService) GetServeModelById(id string) (*openapi.ServeMo
 		return nil, fmt.Errorf("multiple ServeModels found for id %s: %w", id, api.ErrNotFound)
 	}
 
-	if len(executionsResp.Executions) == 0 {
+	if len(executionsResp.Executions) == 0 || !serv.ownedByTenant(executionsResp.Executions[0].Properties) {
 		return nil, fmt.Errorf("no ServeModel found for id %s: %w", id, api.ErrNotFound)
 	}
 
@@ -1408,6 +1613,9 @@ func (serv *ModelRegistryService) GetServeModelById(id string) (*openapi.ServeMo
 
 // GetServeModels retrieves a list of serve models based on the provided list options and optional inference service ID.
 func (serv *ModelRegistryService) GetServeModels(listOptions api.ListOptions, inferenceServiceId *string) (*openapi.ServeModelList, error) {
+	serv, span := serv.startSpan("GetServeModels")
+	defer span.End()
+
 	listOperationOptions, err := apiutils.BuildListOperationOptions(listOptions)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
@@ -1420,7 +1628,13 @@ func (serv *ModelRegistryService) GetServeModels(listOptions api.ListOptions, in
 		if err != nil {
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
-		executionsResp, err := serv.mlmdClient.GetExecutionsByContext(context.Background(), &proto.GetExecutionsByContextRequest{
+		if serv.tenant != "" {
+			// executions are listed by context, make sure the inference service belongs to the tenant
+			if _, err := serv.GetInferenceServiceById(*inferenceServiceId); err != nil {
+				return nil, err
+			}
+		}
+		executionsResp, err := serv.mlmdClient.GetExecutionsByContext(serv.ctx, &proto.GetExecutionsByContextRequest{
 			ContextId: ctxId,
 			Options:   listOperationOptions,
 		})
@@ -1430,7 +1644,10 @@ func (serv *ModelRegistryService) GetServeModels(listOptions api.ListOptions, in
 		executions = executionsResp.Executions
 		nextPageToken = executionsResp.NextPageToken
 	} else {
-		executionsResp, err := serv.mlmdClient.GetExecutionsByType(context.Background(), &proto.GetExecutionsByTypeRequest{
+		if query := serv.tenantQuery(""); query != "" {
+			listOperationOptions.FilterQuery = &query
+		}
+		executionsResp, err := serv.mlmdClient.GetExecutionsByType(serv.ctx, &proto.GetExecutionsByTypeRequest{
 			TypeName: &serv.nameConfig.ServeModelTypeName,
 			Options:  listOperationOptions,
 		})
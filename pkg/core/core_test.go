@@ -2,7 +2,13 @@ package core
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
@@ -15,6 +21,7 @@ import (
 	"github.com/kubeflow/model-registry/internal/testutils"
 	"github.com/kubeflow/model-registry/pkg/api"
 	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/kubeflow/model-registry/pkg/signing"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 )
@@ -368,49 +375,49 @@ func (suite *CoreTestSuite) TestModelRegistryStartupWithExistingEmptyTypes() {
 	})
 	suite.NotNilf(regModelResp.ContextType, "registered model type %s should exists", *registeredModelTypeName)
 	suite.Equal(*registeredModelTypeName, *regModelResp.ContextType.Name)
-	suite.Equal(3, len(regModelResp.ContextType.Properties))
+	suite.Equal(4, len(regModelResp.ContextType.Properties))
 
 	modelVersionResp, _ = suite.mlmdClient.GetContextType(ctx, &proto.GetContextTypeRequest{
 		TypeName: modelVersionTypeName,
 	})
 	suite.NotNilf(modelVersionResp.ContextType, "model version type %s should exists", *modelVersionTypeName)
 	suite.Equal(*modelVersionTypeName, *modelVersionResp.ContextType.Name)
-	suite.Equal(5, len(modelVersionResp.ContextType.Properties))
+	suite.Equal(6, len(modelVersionResp.ContextType.Properties))
 
 	docArtifactResp, _ = suite.mlmdClient.GetArtifactType(ctx, &proto.GetArtifactTypeRequest{
 		TypeName: docArtifactTypeName,
 	})
 	suite.NotNilf(docArtifactResp.ArtifactType, "doc artifact type %s should exists", *docArtifactTypeName)
 	suite.Equal(*docArtifactTypeName, *docArtifactResp.ArtifactType.Name)
-	suite.Equal(1, len(docArtifactResp.ArtifactType.Properties))
+	suite.Equal(2, len(docArtifactResp.ArtifactType.Properties))
 
 	modelArtifactResp, _ = suite.mlmdClient.GetArtifactType(ctx, &proto.GetArtifactTypeRequest{
 		TypeName: modelArtifactTypeName,
 	})
 	suite.NotNilf(modelArtifactResp.ArtifactType, "model artifact type %s should exists", *modelArtifactTypeName)
 	suite.Equal(*modelArtifactTypeName, *modelArtifactResp.ArtifactType.Name)
-	suite.Equal(6, len(modelArtifactResp.ArtifactType.Properties))
+	suite.Equal(7, len(modelArtifactResp.ArtifactType.Properties))
 
 	servingEnvResp, _ = suite.mlmdClient.GetContextType(ctx, &proto.GetContextTypeRequest{
 		TypeName: servingEnvironmentTypeName,
 	})
 	suite.NotNilf(servingEnvResp.ContextType, "serving environment type %s should exists", *servingEnvironmentTypeName)
 	suite.Equal(*servingEnvironmentTypeName, *servingEnvResp.ContextType.Name)
-	suite.Equal(1, len(servingEnvResp.ContextType.Properties))
+	suite.Equal(2, len(servingEnvResp.ContextType.Properties))
 
 	inferenceServiceResp, _ = suite.mlmdClient.GetContextType(ctx, &proto.GetContextTypeRequest{
 		TypeName: inferenceServiceTypeName,
 	})
 	suite.NotNilf(inferenceServiceResp.ContextType, "inference service type %s should exists", *inferenceServiceTypeName)
 	suite.Equal(*inferenceServiceTypeName, *inferenceServiceResp.ContextType.Name)
-	suite.Equal(6, len(inferenceServiceResp.ContextType.Properties))
+	suite.Equal(7, len(inferenceServiceResp.ContextType.Properties))
 
 	serveModelResp, _ = suite.mlmdClient.GetExecutionType(ctx, &proto.GetExecutionTypeRequest{
 		TypeName: serveModelTypeName,
 	})
 	suite.NotNilf(serveModelResp.ExecutionType, "serve model type %s should exists", *serveModelTypeName)
 	suite.Equal(*serveModelTypeName, *serveModelResp.ExecutionType.Name)
-	suite.Equal(2, len(serveModelResp.ExecutionType.Properties))
+	suite.Equal(3, len(serveModelResp.ExecutionType.Properties))
 }
 
 func (suite *CoreTestSuite) TestModelRegistryTypes() {
@@ -630,6 +637,23 @@ func (suite *CoreTestSuite) TestCreateRegisteredModel() {
 	suite.Equal(1, len(getAllResp.Contexts), "there should be just one context saved in mlmd")
 }
 
+func (suite *CoreTestSuite) TestCreateDuplicateRegisteredModelConflict() {
+	// create mode registry service
+	service := suite.setupModelRegistryService()
+
+	_, err := service.UpsertRegisteredModel(&openapi.RegisteredModel{Name: &modelName})
+	suite.Nilf(err, "error creating registered model: %v", err)
+
+	// test
+	_, err = service.UpsertRegisteredModel(&openapi.RegisteredModel{Name: &modelName})
+
+	// checks
+	suite.NotNil(err)
+	suite.ErrorIs(err, api.ErrConflict, "a duplicate name should be reported as a conflict")
+	suite.Equal(http.StatusConflict, api.ErrToStatus(err))
+	suite.NotContains(err.Error(), "rpc error", "the raw gRPC error should not be exposed")
+}
+
 func (suite *CoreTestSuite) TestUpdateRegisteredModel() {
 	// create mode registry service
 	service := suite.setupModelRegistryService()
@@ -724,6 +748,51 @@ func (suite *CoreTestSuite) TestUpdateRegisteredModel() {
 	suite.Equal(newCustomProp, ctx.CustomProperties["owner"].GetStringValue(), "check can define custom property 'onwer' and should match the provided one")
 }
 
+func (suite *CoreTestSuite) TestUpdateRegisteredModelCustomProperties() {
+	// create mode registry service
+	service := suite.setupModelRegistryService()
+
+	createdModel, err := service.UpsertRegisteredModel(&openapi.RegisteredModel{
+		Name: &modelName,
+		CustomProperties: &map[string]openapi.MetadataValue{
+			"kept":             {MetadataStringValue: converter.NewMetadataStringValue("kept")},
+			"removed":          {MetadataStringValue: converter.NewMetadataStringValue("removed")},
+			"_mr.reserved.key": {MetadataStringValue: converter.NewMetadataStringValue("reserved")},
+		},
+	})
+	suite.Nilf(err, "error creating registered model: %v", err)
+
+	// nil custom properties keep the stored ones
+	createdModel.CustomProperties = nil
+	updatedModel, err := service.UpsertRegisteredModel(createdModel)
+	suite.Nilf(err, "error updating registered model: %v", err)
+	suite.Len(updatedModel.GetCustomProperties(), 3)
+
+	// omitted custom properties are removed, except the reserved ones
+	updatedModel.CustomProperties = &map[string]openapi.MetadataValue{
+		"kept":  {MetadataStringValue: converter.NewMetadataStringValue("kept")},
+		"added": {MetadataStringValue: converter.NewMetadataStringValue("added")},
+	}
+	updatedModel, err = service.UpsertRegisteredModel(updatedModel)
+	suite.Nilf(err, "error updating registered model: %v", err)
+
+	createdModelId, _ := converter.StringToInt64(createdModel.Id)
+	ctxById, err := suite.mlmdClient.GetContextsByID(context.Background(), &proto.GetContextsByIDRequest{
+		ContextIds: []int64{*createdModelId},
+	})
+	suite.Nilf(err, "error retrieving context by id, not related to the test itself: %v", err)
+	ctx := ctxById.Contexts[0]
+	suite.Equal("kept", ctx.CustomProperties["kept"].GetStringValue())
+	suite.Equal("added", ctx.CustomProperties["added"].GetStringValue())
+	suite.Equal("reserved", ctx.CustomProperties["_mr.reserved.key"].GetStringValue())
+	suite.NotContains(ctx.CustomProperties, "removed", "removed custom property should be dropped by mlmd")
+	suite.Equal(updatedModel.GetCustomProperties(), map[string]openapi.MetadataValue{
+		"kept":             {MetadataStringValue: converter.NewMetadataStringValue("kept")},
+		"added":            {MetadataStringValue: converter.NewMetadataStringValue("added")},
+		"_mr.reserved.key": {MetadataStringValue: converter.NewMetadataStringValue("reserved")},
+	})
+}
+
 func (suite *CoreTestSuite) TestGetRegisteredModelById() {
 	// create mode registry service
 	service := suite.setupModelRegistryService()
@@ -3176,6 +3245,13 @@ func (suite *CoreTestSuite) TestUpdateServeModelFailure() {
 	updatedEntity, err := service.UpsertServeModel(createdEntity, &inferenceServiceId)
 	suite.Nilf(err, "error updating entity for %d: %v", inferenceServiceId, err)
 
+	otherEnvironment, otherEnvironmentExtId := "otherServingEnvironment", "otherServingEnvironment ExtID"
+	otherName, otherExtId := "otherInferenceService", "otherInferenceService ExtID"
+	otherInferenceServiceId := suite.registerInferenceService(service, registeredModelId, &otherEnvironment, &otherEnvironmentExtId, &otherName, &otherExtId)
+	_, err = service.UpsertServeModel(updatedEntity, &otherInferenceServiceId)
+	suite.NotNil(err)
+	suite.Equal(fmt.Sprintf("no ServeModel found for id %s in InferenceService %s: not found", *updatedEntity.Id, otherInferenceServiceId), err.Error())
+
 	wrongId := "9998"
 	updatedEntity.Id = &wrongId
 	_, err = service.UpsertServeModel(updatedEntity, &inferenceServiceId)
@@ -3326,3 +3402,281 @@ func (suite *CoreTestSuite) TestGetServeModels() {
 	suite.Equal(*converter.Int64ToString(createdEntityId2), *getAllByInferenceService.Items[1].Id)
 	suite.Equal(*converter.Int64ToString(createdEntityId3), *getAllByInferenceService.Items[0].Id)
 }
+
+// TENANTS
+
+func (suite *CoreTestSuite) TestTenantIsolation() {
+	// create mode registry service
+	service := suite.setupModelRegistryService()
+
+	_, err := service.ForTenant("Not_A_Valid_Tenant")
+	suite.NotNilf(err, "invalid tenant should be rejected")
+
+	teamA, err := service.ForTenant("team-a")
+	suite.Nilf(err, "error creating tenant scoped service: %v", err)
+	teamB, err := service.ForTenant("team-b")
+	suite.Nilf(err, "error creating tenant scoped service: %v", err)
+
+	// the same name can be registered by both tenants
+	externalIdA := "team-a-model"
+	externalIdB := "team-b-model"
+	modelIdA := suite.registerModel(teamA, nil, &externalIdA)
+	modelIdB := suite.registerModel(teamB, nil, &externalIdB)
+	suite.NotEqual(modelIdA, modelIdB, "models registered by different tenants should be distinct")
+
+	found, err := teamA.GetRegisteredModelById(modelIdA)
+	suite.Nilf(err, "error getting registered model by id %s: %v", modelIdA, err)
+	suite.Equal(modelName, *found.Name, "tenant prefix should not be exposed in the model name")
+
+	_, err = teamA.GetRegisteredModelById(modelIdB)
+	suite.ErrorIs(err, api.ErrNotFound, "models of other tenants should not be found")
+
+	byName, err := teamB.GetRegisteredModelByParams(&modelName, nil)
+	suite.Nilf(err, "error getting registered model by name: %v", err)
+	suite.Equal(modelIdB, *byName.Id, "model found by name should belong to the tenant")
+
+	_, err = teamB.GetRegisteredModelByParams(nil, &externalIdA)
+	suite.ErrorIs(err, api.ErrNotFound, "models of other tenants should not be found by external id")
+
+	listA, err := teamA.GetRegisteredModels(api.ListOptions{})
+	suite.Nilf(err, "error getting registered models: %v", err)
+	suite.Equal(int32(1), listA.Size, "only the tenant models should be listed")
+	suite.Equal(modelIdA, *listA.Items[0].Id)
+
+	all, err := service.GetRegisteredModels(api.ListOptions{})
+	suite.Nilf(err, "error getting registered models: %v", err)
+	suite.Equal(int32(2), all.Size, "unscoped service should list models of all tenants")
+
+	// serving environments are isolated the same way
+	envA, err := teamA.UpsertServingEnvironment(&openapi.ServingEnvironment{Name: &entityName})
+	suite.Nilf(err, "error creating serving environment: %v", err)
+	suite.Equal(entityName, *envA.Name, "tenant prefix should not be exposed in the serving environment name")
+	envB, err := teamB.UpsertServingEnvironment(&openapi.ServingEnvironment{Name: &entityName})
+	suite.Nilf(err, "error creating serving environment: %v", err)
+	suite.NotEqual(*envA.Id, *envB.Id, "serving environments created by different tenants should be distinct")
+
+	_, err = teamA.GetServingEnvironmentById(*envB.Id)
+	suite.ErrorIs(err, api.ErrNotFound, "serving environments of other tenants should not be found")
+
+	envByName, err := teamB.GetServingEnvironmentByParams(&entityName, nil)
+	suite.Nilf(err, "error getting serving environment by name: %v", err)
+	suite.Equal(*envB.Id, *envByName.Id, "serving environment found by name should belong to the tenant")
+	suite.Equal(entityName, *envByName.Name)
+
+	envListA, err := teamA.GetServingEnvironments(api.ListOptions{})
+	suite.Nilf(err, "error getting serving environments: %v", err)
+	suite.Equal(int32(1), envListA.Size, "only the tenant serving environments should be listed")
+	suite.Equal(entityName, *envListA.Items[0].Name)
+
+	// a read then update round trip keeps the name
+	envDescription := "updated"
+	envA.Description = &envDescription
+	envA, err = teamA.UpsertServingEnvironment(envA)
+	suite.Nilf(err, "error updating serving environment: %v", err)
+	suite.Equal(entityName, *envA.Name)
+	envByName, err = teamA.GetServingEnvironmentByParams(&entityName, nil)
+	suite.Nilf(err, "error getting serving environment by name: %v", err)
+	suite.Equal(*envA.Id, *envByName.Id, "updated serving environment should still be found by name")
+
+	isName := "is-a"
+	is, err := teamA.UpsertInferenceService(&openapi.InferenceService{Name: &isName, ServingEnvironmentId: *envA.Id, RegisteredModelId: modelIdA})
+	suite.Nilf(err, "error creating inference service: %v", err)
+	_, err = teamA.UpsertInferenceService(is)
+	suite.Nilf(err, "error updating inference service: %v", err)
+
+	versionName := "v1"
+	_, err = teamB.UpsertModelVersion(&openapi.ModelVersion{Name: &versionName}, &modelIdA)
+	suite.ErrorIs(err, api.ErrNotFound, "model versions should not be created under models of other tenants")
+}
+
+// PROPERTY SCHEMAS
+
+func (suite *CoreTestSuite) TestPropertySchemas() {
+	mlmdtypeNames := mlmdtypes.NewMLMDTypeNamesConfigFromDefaults()
+	_, err := mlmdtypes.CreateMLMDTypes(suite.grpcConn, mlmdtypeNames)
+	suite.Nilf(err, "error creating MLMD types: %v", err)
+	globalSchema := openapi.PropertySchema{
+		EntityType: apiutils.Of("ModelArtifact"),
+		Properties: &map[string]openapi.PropertyDefinition{
+			"framework": {MetadataType: "MetadataStringValue", Enum: []string{"onnx", "pytorch"}},
+		},
+	}
+	service, err := NewModelRegistryService(suite.grpcConn, mlmdtypeNames, WithPropertySchemas(globalSchema))
+	suite.Nilf(err, "error creating core service: %v", err)
+
+	registeredModelId := suite.registerModel(service, nil, nil)
+
+	_, err = service.GetPropertySchema("ModelVersion", &registeredModelId)
+	suite.ErrorIs(err, api.ErrNotFound, "no schema should be found for model versions")
+	schema, err := service.GetPropertySchema("ModelArtifact", &registeredModelId)
+	suite.Nilf(err, "error getting global schema: %v", err)
+	suite.Nil(schema.RegisteredModelId, "the global schema should apply")
+
+	// attach a schema to the registered model
+	schema, err = service.UpsertPropertySchema(&openapi.PropertySchema{
+		EntityType: apiutils.Of("ModelVersion"),
+		Properties: &map[string]openapi.PropertyDefinition{
+			"accuracy": {MetadataType: "MetadataDoubleValue"},
+		},
+		Required: []string{"accuracy"},
+		Strict:   apiutils.Of(true),
+	}, registeredModelId)
+	suite.Nilf(err, "error attaching schema: %v", err)
+	suite.Equal(registeredModelId, *schema.RegisteredModelId)
+	suite.Equal("ModelVersion", *schema.EntityType)
+
+	// missing, mistyped and undefined properties are all reported
+	_, err = service.UpsertModelVersion(&openapi.ModelVersion{
+		Name: &modelVersionName,
+		CustomProperties: &map[string]openapi.MetadataValue{
+			"acc": {MetadataDoubleValue: converter.NewMetadataDoubleValue(0.9)},
+		},
+	}, &registeredModelId)
+	suite.ErrorIs(err, api.ErrValidation)
+	suite.Equal(http.StatusUnprocessableEntity, api.ErrToStatus(err))
+	suite.Equal([]api.FieldError{
+		{Field: "customProperties.acc", Message: "is not defined in the property schema"},
+		{Field: "customProperties.accuracy", Message: "is required"},
+	}, api.ErrToDetails(err))
+
+	version, err := service.UpsertModelVersion(&openapi.ModelVersion{
+		Name: &modelVersionName,
+		CustomProperties: &map[string]openapi.MetadataValue{
+			"accuracy": {MetadataDoubleValue: converter.NewMetadataDoubleValue(0.9)},
+		},
+	}, &registeredModelId)
+	suite.Nilf(err, "error creating model version: %v", err)
+
+	// the required property is already stored
+	version.Description = &modelVersionDescription
+	version.CustomProperties = nil
+	_, err = service.UpsertModelVersion(version, nil)
+	suite.Nilf(err, "error updating model version: %v", err)
+
+	// artifacts are checked against the global schema
+	_, err = service.UpsertModelArtifact(&openapi.ModelArtifact{
+		Name: &artifactName,
+		CustomProperties: &map[string]openapi.MetadataValue{
+			"framework": {MetadataStringValue: converter.NewMetadataStringValue("tensorflow")},
+		},
+	}, version.Id)
+	suite.ErrorIs(err, api.ErrValidation)
+	suite.Equal("customProperties.framework", api.ErrToDetails(err)[0].Field)
+}
+
+// MODEL ARTIFACT SIGNATURES
+
+func (suite *CoreTestSuite) TestModelArtifactSignatures() {
+	service := suite.setupModelRegistryService()
+	modelVersionId := suite.registerModelVersion(service, nil, nil, nil, nil)
+
+	unsigned, err := service.UpsertModelArtifact(&openapi.ModelArtifact{Name: apiutils.Of("unsigned")}, &modelVersionId)
+	suite.Nilf(err, "error creating model artifact: %v", err)
+	digests := []openapi.ArtifactDigest{
+		{Algorithm: "sha256", Value: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+	}
+	modelArtifact, err := service.UpsertModelArtifact(&openapi.ModelArtifact{
+		Name:             &artifactName,
+		Uri:              &artifactUri,
+		Digests:          digests,
+		CustomProperties: &map[string]openapi.MetadataValue{"framework": {MetadataStringValue: converter.NewMetadataStringValue("onnx")}},
+	}, &modelVersionId)
+	suite.Nilf(err, "error creating model artifact: %v", err)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	suite.Nilf(err, "error generating key: %v", err)
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	suite.Nilf(err, "error encoding public key: %v", err)
+	publicKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	manifest, err := signing.Manifest(digests)
+	suite.Nilf(err, "error building manifest: %v", err)
+	signed, err := signing.Sign(privateKey, manifest)
+	suite.Nilf(err, "error signing manifest: %v", err)
+	signature := openapi.NewArtifactSignatureCreate(publicKeyPEM, base64.StdEncoding.EncodeToString(signed))
+	signature.Signer = apiutils.Of("release-team")
+
+	_, err = service.UpsertModelArtifactSignature(signature, *unsigned.Id)
+	suite.ErrorIs(err, api.ErrBadRequest, "artifacts without digests can't be signed")
+	_, err = service.UpsertModelArtifactSignature(openapi.NewArtifactSignatureCreate(publicKeyPEM, base64.StdEncoding.EncodeToString([]byte("forged"))), *modelArtifact.Id)
+	suite.ErrorIs(err, api.ErrValidation, "signatures not matching the digests should be rejected")
+
+	stored, err := service.UpsertModelArtifactSignature(signature, *modelArtifact.Id)
+	suite.Nilf(err, "error signing model artifact: %v", err)
+	keyId, err := signing.KeyID(publicKey)
+	suite.Nilf(err, "error computing key id: %v", err)
+	suite.Equal(keyId, stored.KeyId)
+	suite.Equal(signing.Ed25519, stored.Algorithm)
+	suite.Equal(signing.ManifestDigest(manifest), stored.ManifestDigest)
+	suite.Equal("release-team", *stored.Signer)
+
+	signatures, err := service.GetModelArtifactSignatures(*modelArtifact.Id)
+	suite.Nilf(err, "error getting signatures: %v", err)
+	suite.Equal(int32(1), signatures.Size)
+	suite.Equal(*stored, signatures.Items[0])
+
+	// signatures are kept by updates of the model artifact
+	modelArtifact.CustomProperties = &map[string]openapi.MetadataValue{}
+	_, err = service.UpsertModelArtifact(modelArtifact, nil)
+	suite.Nilf(err, "error updating model artifact: %v", err)
+	signatures, err = service.GetModelArtifactSignatures(*modelArtifact.Id)
+	suite.Nilf(err, "error getting signatures: %v", err)
+	suite.Equal(int32(1), signatures.Size)
+
+	err = service.DeleteModelArtifactSignature(*modelArtifact.Id, keyId)
+	suite.Nilf(err, "error deleting signature: %v", err)
+	err = service.DeleteModelArtifactSignature(*modelArtifact.Id, keyId)
+	suite.ErrorIs(err, api.ErrNotFound)
+	signatures, err = service.GetModelArtifactSignatures(*modelArtifact.Id)
+	suite.Nilf(err, "error getting signatures: %v", err)
+	suite.Equal(int32(0), signatures.Size)
+	updated, err := service.GetModelArtifactById(*modelArtifact.Id)
+	suite.Nilf(err, "error getting model artifact: %v", err)
+	suite.Equal(artifactUri, *updated.Uri, "other fields should be left untouched")
+	suite.Equal(digests, updated.Digests, "other fields should be left untouched")
+
+	// signatures are removed once the digests they were made over are replaced
+	_, err = service.UpsertModelArtifactSignature(signature, *modelArtifact.Id)
+	suite.Nilf(err, "error signing model artifact: %v", err)
+	updated.Digests = []openapi.ArtifactDigest{
+		{Algorithm: "sha256", Value: "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"},
+	}
+	_, err = service.WithContext(api.WithDigestChangeAllowed(context.Background())).UpsertModelArtifact(updated, nil)
+	suite.Nilf(err, "error replacing digests of model artifact: %v", err)
+	signatures, err = service.GetModelArtifactSignatures(*modelArtifact.Id)
+	suite.Nilf(err, "error getting signatures: %v", err)
+	suite.Equal(int32(0), signatures.Size)
+}
+
+// TYPES
+
+func (suite *CoreTestSuite) TestCustomMLMDTypeNames() {
+	mlmdtypeNames := mlmdtypes.NewMLMDTypeNamesConfigFromDefaults()
+	mlmdtypeNames.ModelVersionTypeName = "team.ModelVersion"
+	mlmdtypeNames.ModelArtifactTypeName = "team.ModelArtifact"
+
+	_, err := mlmdtypes.ValidateMLMDTypes(suite.grpcConn, mlmdtypeNames)
+	suite.NotNilf(err, "validation should fail before types are created")
+	suite.Contains(err.Error(), "missing context type team.ModelVersion")
+	suite.Contains(err.Error(), "missing artifact type team.ModelArtifact")
+
+	_, err = mlmdtypes.CreateMLMDTypes(suite.grpcConn, mlmdtypeNames)
+	suite.Nilf(err, "error creating MLMD types: %v", err)
+	_, err = mlmdtypes.ValidateMLMDTypes(suite.grpcConn, mlmdtypeNames)
+	suite.Nilf(err, "validation should succeed once types are created: %v", err)
+
+	service, err := NewModelRegistryService(suite.grpcConn, mlmdtypeNames)
+	suite.Nilf(err, "error creating core service: %v", err)
+
+	modelVersionId := suite.registerModelVersion(service, nil, nil, nil, nil)
+	artifact, err := service.UpsertModelArtifact(&openapi.ModelArtifact{Name: &artifactName, Uri: &artifactUri}, &modelVersionId)
+	suite.Nilf(err, "error creating model artifact: %v", err)
+
+	version, err := service.GetModelVersionById(modelVersionId)
+	suite.Nilf(err, "error getting model version: %v", err)
+	suite.Equal(modelVersionName, *version.Name)
+
+	artifacts, err := service.GetArtifacts(api.ListOptions{}, &modelVersionId)
+	suite.Nilf(err, "error getting artifacts: %v", err)
+	suite.Equal(int32(1), artifacts.Size)
+	suite.Equal(*artifact.Id, *artifacts.Items[0].ModelArtifact.Id)
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// digestLengths are the lengths of hex encoded digests, by supported algorithm
+var digestLengths = map[string]int{
+	"sha256": 64,
+	"sha512": 128,
+}
+
+// checkDigests validates the digests of modelArtifact and, when updating existing, rejects changes to the digests
+// recorded on it unless the context of the call allows them, as registered content is not expected to change. When
+// allowed, the signatures of existing are removed from modelArtifact, as they were made over the previous digests.
+func (serv *ModelRegistryService) checkDigests(modelArtifact *openapi.ModelArtifact, existing *openapi.ModelArtifact) error {
+	violations := []api.FieldError{}
+	seen := map[string]bool{}
+	for i, digest := range modelArtifact.Digests {
+		field := fmt.Sprintf("digests[%d]", i)
+		if length, ok := digestLengths[digest.Algorithm]; !ok {
+			violations = append(violations, api.FieldError{Field: field + ".algorithm", Message: "must be one of sha256, sha512"})
+		} else if _, err := hex.DecodeString(digest.Value); err != nil || len(digest.Value) != length {
+			violations = append(violations, api.FieldError{Field: field + ".value", Message: fmt.Sprintf("must be a hex encoded %s digest", digest.Algorithm)})
+		}
+		if digest.Path != nil && !validDigestPath(*digest.Path) {
+			violations = append(violations, api.FieldError{Field: field + ".path", Message: "must be a clean path relative to the artifact uri"})
+		}
+		key := digestKey(digest)
+		if seen[key] {
+			violations = append(violations, api.FieldError{Field: field, Message: "is a duplicate of a previous digest"})
+		}
+		seen[key] = true
+	}
+	if len(violations) > 0 {
+		return api.NewValidationError(violations...)
+	}
+
+	if existing == nil || sameDigests(existing.Digests, modelArtifact.Digests) {
+		return nil
+	}
+	if len(existing.Digests) > 0 && !api.DigestChangeAllowed(serv.ctx) {
+		return fmt.Errorf("digests of model artifact %s can't be changed or removed unless explicitly allowed: %w", existing.GetId(), api.ErrConflict)
+	}
+	clearSignatures(modelArtifact)
+	return nil
+}
+
+// validDigestPath returns whether p is a clean relative path within the artifact
+func validDigestPath(p string) bool {
+	return p == path.Clean(p) && !path.IsAbs(p) && p != "." && p != ".." && !strings.HasPrefix(p, "../")
+}
+
+// sameDigests returns whether a and b record the same digests, regardless of their order and of the case of values
+func sameDigests(a []openapi.ArtifactDigest, b []openapi.ArtifactDigest) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	values := make(map[string]string, len(a))
+	for _, digest := range a {
+		values[digestKey(digest)] = strings.ToLower(digest.Value)
+	}
+	for _, digest := range b {
+		if value, ok := values[digestKey(digest)]; !ok || value != strings.ToLower(digest.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// digestKey identifies the file and algorithm of digest
+func digestKey(digest openapi.ArtifactDigest) string {
+	return digest.GetPath() + "\x00" + digest.Algorithm
+}
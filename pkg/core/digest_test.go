@@ -0,0 +1,72 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDigests(t *testing.T) {
+	assertion := assert.New(t)
+	serv := &ModelRegistryService{ctx: context.Background()}
+	sha256 := strings.Repeat("ab", 32)
+	recorded := &openapi.ModelArtifact{
+		Id: apiutils.Of("1"),
+		Digests: []openapi.ArtifactDigest{
+			{Path: apiutils.Of("model.onnx"), Algorithm: "sha256", Value: sha256},
+			{Path: apiutils.Of("config/labels.txt"), Algorithm: "sha256", Value: sha256},
+		},
+	}
+
+	assertion.Nil(serv.checkDigests(recorded, nil))
+	assertion.Nil(serv.checkDigests(recorded, &openapi.ModelArtifact{Id: apiutils.Of("1")}), "digests can be added")
+	assertion.Nil(serv.checkDigests(&openapi.ModelArtifact{
+		Digests: []openapi.ArtifactDigest{recorded.Digests[1], {Path: apiutils.Of("model.onnx"), Algorithm: "sha256", Value: strings.ToUpper(sha256)}},
+	}, recorded), "order and case don't matter")
+
+	err := serv.checkDigests(&openapi.ModelArtifact{
+		Digests: []openapi.ArtifactDigest{
+			{Algorithm: "md5", Value: "abc"},
+			{Path: apiutils.Of("../model.onnx"), Algorithm: "sha512", Value: sha256},
+			{Path: apiutils.Of("/model.onnx"), Algorithm: "sha256", Value: strings.Repeat("zz", 32)},
+			{Algorithm: "sha256", Value: sha256},
+			{Algorithm: "sha256", Value: sha256},
+		},
+	}, nil)
+	assertion.ErrorIs(err, api.ErrValidation)
+	assertion.Equal([]api.FieldError{
+		{Field: "digests[0].algorithm", Message: "must be one of sha256, sha512"},
+		{Field: "digests[1].value", Message: "must be a hex encoded sha512 digest"},
+		{Field: "digests[1].path", Message: "must be a clean path relative to the artifact uri"},
+		{Field: "digests[2].value", Message: "must be a hex encoded sha256 digest"},
+		{Field: "digests[2].path", Message: "must be a clean path relative to the artifact uri"},
+		{Field: "digests[4]", Message: "is a duplicate of a previous digest"},
+	}, api.ErrToDetails(err))
+
+	changed := &openapi.ModelArtifact{Digests: []openapi.ArtifactDigest{recorded.Digests[0]}}
+	assertion.ErrorIs(serv.checkDigests(changed, recorded), api.ErrConflict)
+	assertion.ErrorIs(serv.checkDigests(&openapi.ModelArtifact{}, recorded), api.ErrConflict, "digests can't be removed")
+
+	allowed := &ModelRegistryService{ctx: api.WithDigestChangeAllowed(context.Background())}
+	assertion.Nil(allowed.checkDigests(changed, recorded))
+	assertion.Nil(allowed.checkDigests(&openapi.ModelArtifact{}, recorded))
+
+	signed := &openapi.ModelArtifact{
+		Digests: recorded.Digests,
+		CustomProperties: &map[string]openapi.MetadataValue{
+			signaturePrefix + "key": {MetadataStringValue: openapi.NewMetadataStringValue("{}", "MetadataStringValue")},
+			"author":                {MetadataStringValue: openapi.NewMetadataStringValue("alice", "MetadataStringValue")},
+		},
+	}
+	assertion.Nil(allowed.checkDigests(signed, recorded))
+	assertion.Contains(*signed.CustomProperties, signaturePrefix+"key", "signatures are kept while the digests don't change")
+	signed.Digests = changed.Digests
+	assertion.Nil(allowed.checkDigests(signed, recorded))
+	assertion.NotContains(*signed.CustomProperties, signaturePrefix+"key", "signatures of the previous digests should be removed")
+	assertion.Contains(*signed.CustomProperties, "author")
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mlmdError is a gRPC status error returned by MLMD, classified as one of the api errors
+type mlmdError struct {
+	status *status.Status
+	class  error
+}
+
+func (e *mlmdError) Error() string {
+	return fmt.Sprintf("%s: %s", e.status.Message(), e.class)
+}
+
+func (e *mlmdError) Unwrap() error {
+	return e.class
+}
+
+// GRPCStatus keeps the original status available to status.FromError and status.Code
+func (e *mlmdError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// translateMLMDError classifies the gRPC status errors returned by MLMD as api errors, so that they're
+// reported with a meaningful HTTP status, other errors are returned as they are
+func translateMLMDError(err error) error {
+	st, ok := status.FromError(err)
+	if err == nil || !ok {
+		return err
+	}
+	var class error
+	switch st.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		class = api.ErrBadRequest
+	case codes.NotFound:
+		class = api.ErrNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		class = api.ErrConflict
+	case codes.FailedPrecondition:
+		class = api.ErrPreconditionFailed
+	case codes.PermissionDenied, codes.Unauthenticated:
+		class = api.ErrForbidden
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		class = api.ErrUnavailable
+	default:
+		return err
+	}
+	return &mlmdError{status: st, class: class}
+}
+
+// errorTranslatingConn is a client connection translating the errors of every MLMD call with translateMLMDError
+type errorTranslatingConn struct {
+	grpc.ClientConnInterface
+}
+
+func (c errorTranslatingConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	return translateMLMDError(c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...))
+}
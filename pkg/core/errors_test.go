@@ -0,0 +1,28 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslateMLMDError(t *testing.T) {
+	assertion := assert.New(t)
+
+	err := translateMLMDError(status.Error(codes.AlreadyExists, "Given node already exists: type_id: 12 name: \"model\""))
+	assertion.True(errors.Is(err, api.ErrConflict))
+	assertion.Equal("Given node already exists: type_id: 12 name: \"model\": conflict", err.Error())
+	assertion.Equal(codes.AlreadyExists, status.Code(err), "the original gRPC status should be preserved")
+
+	assertion.True(errors.Is(translateMLMDError(status.Error(codes.Unavailable, "connection refused")), api.ErrUnavailable))
+	assertion.True(errors.Is(translateMLMDError(status.Error(codes.FailedPrecondition, "type mismatch")), api.ErrPreconditionFailed))
+	assertion.True(errors.Is(translateMLMDError(status.Error(codes.PermissionDenied, "denied")), api.ErrForbidden))
+
+	internal := status.Error(codes.Internal, "boom")
+	assertion.Equal(internal, translateMLMDError(internal))
+	assertion.Nil(translateMLMDError(nil))
+}
@@ -0,0 +1,268 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kubeflow/model-registry/internal/converter"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+const (
+	// reservedPrefix prefixes the custom properties managed by the registry itself
+	reservedPrefix = "_mr."
+	// propertySchemaPrefix prefixes the custom properties of registered models holding their property schemas,
+	// one per entity type
+	propertySchemaPrefix = reservedPrefix + "propertySchema."
+)
+
+// propertySchemaEntityTypes are the entity types custom property schemas can be defined for
+var propertySchemaEntityTypes = []string{"ModelVersion", "ModelArtifact"}
+
+// metadataTypes are the types of MetadataValue, as named in property definitions
+var metadataTypes = []string{"MetadataIntValue", "MetadataDoubleValue", "MetadataStringValue", "MetadataStructValue", "MetadataProtoValue", "MetadataBoolValue", "MetadataListValue"}
+
+// scalarMetadataTypes are the types of MetadataValue that enum values can be defined for, see metadataValueString
+var scalarMetadataTypes = []string{"MetadataIntValue", "MetadataDoubleValue", "MetadataStringValue", "MetadataBoolValue"}
+
+// WithPropertySchemas sets the global custom property schemas, at most one per entity type, applying to the
+// entities of registered models without a schema of their own
+func WithPropertySchemas(schemas ...openapi.PropertySchema) Option {
+	return func(serv *ModelRegistryService) error {
+		serv.propertySchemas = make(map[string]openapi.PropertySchema, len(schemas))
+		for _, schema := range schemas {
+			if err := checkPropertySchema(&schema); err != nil {
+				return fmt.Errorf("invalid property schema for %s: %w", schema.GetEntityType(), err)
+			}
+			if _, ok := serv.propertySchemas[*schema.EntityType]; ok {
+				return fmt.Errorf("duplicate property schema for %s: %w", *schema.EntityType, api.ErrBadRequest)
+			}
+			schema.RegisteredModelId = nil
+			serv.propertySchemas[*schema.EntityType] = schema
+		}
+		return nil
+	}
+}
+
+// GetPropertySchema retrieves the custom property schema of entityType entities, the one attached to the registered
+// model identified by registeredModelId if provided and any, the global one otherwise.
+func (serv *ModelRegistryService) GetPropertySchema(entityType string, registeredModelId *string) (*openapi.PropertySchema, error) {
+	serv, span := serv.startSpan("GetPropertySchema")
+	defer span.End()
+
+	if !slices.Contains(propertySchemaEntityTypes, entityType) {
+		return nil, fmt.Errorf("invalid entity type %s, expected one of %s: %w", entityType, strings.Join(propertySchemaEntityTypes, ", "), api.ErrBadRequest)
+	}
+
+	var registeredModel *openapi.RegisteredModel
+	if registeredModelId != nil {
+		var err error
+		registeredModel, err = serv.GetRegisteredModelById(*registeredModelId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	schema, err := serv.propertySchemaFor(entityType, registeredModel)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, fmt.Errorf("no property schema found for %s: %w", entityType, api.ErrNotFound)
+	}
+	return schema, nil
+}
+
+// UpsertPropertySchema attaches the provided custom property schema to the registered model identified by
+// registeredModelId, replacing the previous one for the same entity type, if any.
+// The schema is stored in a reserved custom property of the registered model.
+func (serv *ModelRegistryService) UpsertPropertySchema(propertySchema *openapi.PropertySchema, registeredModelId string) (*openapi.PropertySchema, error) {
+	serv, span := serv.startSpan("UpsertPropertySchema")
+	defer span.End()
+
+	if propertySchema == nil {
+		return nil, fmt.Errorf("invalid property schema pointer, can't upsert nil: %w", api.ErrBadRequest)
+	}
+	if err := checkPropertySchema(propertySchema); err != nil {
+		return nil, err
+	}
+	registeredModel, err := serv.GetRegisteredModelById(registeredModelId)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := *propertySchema
+	stored.EntityType = nil
+	stored.RegisteredModelId = nil
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling property schema: %w", err)
+	}
+	properties := registeredModel.GetCustomProperties()
+	if properties == nil {
+		properties = map[string]openapi.MetadataValue{}
+	}
+	properties[propertySchemaPrefix+*propertySchema.EntityType] = openapi.MetadataValue{
+		MetadataStringValue: converter.NewMetadataStringValue(string(data)),
+	}
+	registeredModel.CustomProperties = &properties
+	if _, err := serv.UpsertRegisteredModel(registeredModel); err != nil {
+		return nil, err
+	}
+
+	return serv.GetPropertySchema(*propertySchema.EntityType, &registeredModelId)
+}
+
+// propertySchemaFor returns the custom property schema of entityType entities of registeredModel, if any,
+// falling back to the global one when registeredModel is nil or has no schema of its own
+func (serv *ModelRegistryService) propertySchemaFor(entityType string, registeredModel *openapi.RegisteredModel) (*openapi.PropertySchema, error) {
+	if registeredModel != nil {
+		value, ok := registeredModel.GetCustomProperties()[propertySchemaPrefix+entityType]
+		if ok && value.MetadataStringValue != nil {
+			schema := openapi.PropertySchema{}
+			if err := json.Unmarshal([]byte(value.MetadataStringValue.StringValue), &schema); err != nil {
+				return nil, fmt.Errorf("invalid %s property schema of registered model %s: %w", entityType, *registeredModel.Id, err)
+			}
+			schema.EntityType = &entityType
+			schema.RegisteredModelId = registeredModel.Id
+			return &schema, nil
+		}
+	}
+	if schema, ok := serv.propertySchemas[entityType]; ok {
+		return &schema, nil
+	}
+	return nil, nil
+}
+
+// checkCustomProperties checks the custom properties of an entityType entity of registeredModel being stored against
+// the applicable schema, if any. When updating, existing holds the stored custom properties: unchanged properties are
+// not checked again so that entities stored before the schema was attached can still be updated.
+func (serv *ModelRegistryService) checkCustomProperties(entityType string, registeredModel *openapi.RegisteredModel, properties map[string]openapi.MetadataValue, existing map[string]openapi.MetadataValue) error {
+	schema, err := serv.propertySchemaFor(entityType, registeredModel)
+	if err != nil || schema == nil {
+		return err
+	}
+
+	violations := []api.FieldError{}
+	definitions := schema.GetProperties()
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		value := properties[key]
+		if stored, ok := existing[key]; ok && reflect.DeepEqual(stored, value) {
+			continue
+		}
+		field := "customProperties." + key
+		definition, ok := definitions[key]
+		if !ok {
+			if schema.GetStrict() && !strings.HasPrefix(key, reservedPrefix) {
+				violations = append(violations, api.FieldError{Field: field, Message: "is not defined in the property schema"})
+			}
+			continue
+		}
+		if metadataType := metadataTypeOf(value); metadataType != definition.MetadataType {
+			violations = append(violations, api.FieldError{Field: field, Message: fmt.Sprintf("must be a %s, not a %s", definition.MetadataType, metadataType)})
+			continue
+		}
+		if len(definition.Enum) > 0 && !slices.Contains(definition.Enum, metadataValueString(value)) {
+			violations = append(violations, api.FieldError{Field: field, Message: fmt.Sprintf("must be one of %s", strings.Join(definition.Enum, ", "))})
+		}
+	}
+	for _, key := range schema.Required {
+		if _, ok := properties[key]; !ok {
+			violations = append(violations, api.FieldError{Field: "customProperties." + key, Message: "is required"})
+		}
+	}
+
+	if len(violations) > 0 {
+		return api.NewValidationError(violations...)
+	}
+	return nil
+}
+
+// checkPropertySchema returns an api.ValidationError listing the issues of the provided schema, if any
+func checkPropertySchema(schema *openapi.PropertySchema) error {
+	violations := []api.FieldError{}
+	if !slices.Contains(propertySchemaEntityTypes, schema.GetEntityType()) {
+		violations = append(violations, api.FieldError{Field: "entityType", Message: fmt.Sprintf("must be one of %s", strings.Join(propertySchemaEntityTypes, ", "))})
+	}
+	definitions := schema.GetProperties()
+	for key, definition := range definitions {
+		field := "properties." + key
+		if key == "" || strings.HasPrefix(key, reservedPrefix) {
+			violations = append(violations, api.FieldError{Field: field, Message: fmt.Sprintf("key must not be empty nor start with %s", reservedPrefix)})
+		}
+		if !slices.Contains(metadataTypes, definition.MetadataType) {
+			violations = append(violations, api.FieldError{Field: field + ".metadataType", Message: fmt.Sprintf("must be one of %s", strings.Join(metadataTypes, ", "))})
+		} else if len(definition.Enum) > 0 && !slices.Contains(scalarMetadataTypes, definition.MetadataType) {
+			violations = append(violations, api.FieldError{Field: field + ".enum", Message: fmt.Sprintf("is not supported by %s properties", definition.MetadataType)})
+		}
+	}
+	for _, key := range schema.Required {
+		if _, ok := definitions[key]; !ok {
+			violations = append(violations, api.FieldError{Field: "required", Message: fmt.Sprintf("%s is not defined in properties", key)})
+		}
+	}
+	// report violations in a stable order, map iteration order is random
+	slices.SortStableFunc(violations, func(a, b api.FieldError) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	if len(violations) > 0 {
+		return api.NewValidationError(violations...)
+	}
+	return nil
+}
+
+// metadataTypeOf returns the type of the provided value, as named in property definitions
+func metadataTypeOf(value openapi.MetadataValue) string {
+	switch {
+	case value.MetadataIntValue != nil:
+		return "MetadataIntValue"
+	case value.MetadataDoubleValue != nil:
+		return "MetadataDoubleValue"
+	case value.MetadataStringValue != nil:
+		return "MetadataStringValue"
+	case value.MetadataStructValue != nil:
+		return "MetadataStructValue"
+	case value.MetadataProtoValue != nil:
+		return "MetadataProtoValue"
+	case value.MetadataBoolValue != nil:
+		return "MetadataBoolValue"
+	case value.MetadataListValue != nil:
+		return "MetadataListValue"
+	}
+	return ""
+}
+
+// metadataValueString returns the string representation of scalar values, compared to enum values
+func metadataValueString(value openapi.MetadataValue) string {
+	switch {
+	case value.MetadataIntValue != nil:
+		return value.MetadataIntValue.IntValue
+	case value.MetadataDoubleValue != nil:
+		return strconv.FormatFloat(value.MetadataDoubleValue.DoubleValue, 'f', -1, 64)
+	case value.MetadataStringValue != nil:
+		return value.MetadataStringValue.StringValue
+	case value.MetadataBoolValue != nil:
+		return strconv.FormatBool(value.MetadataBoolValue.BoolValue)
+	}
+	return ""
+}
+
+// checkModelArtifactCustomProperties checks the custom properties of a model artifact of the model version identified
+// by modelVersionId against the applicable schema, see checkCustomProperties
+func (serv *ModelRegistryService) checkModelArtifactCustomProperties(modelArtifact *openapi.ModelArtifact, existing *openapi.ModelArtifact, modelVersionId string) error {
+	registeredModel, err := serv.getRegisteredModelByVersionId(modelVersionId)
+	if err != nil {
+		return err
+	}
+	return serv.checkCustomProperties("ModelArtifact", registeredModel, modelArtifact.GetCustomProperties(), existing.GetCustomProperties())
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/converter"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPropertySchema(t *testing.T) {
+	assertion := assert.New(t)
+
+	err := checkPropertySchema(&openapi.PropertySchema{
+		EntityType: apiutils.Of("ModelVersion"),
+		Properties: &map[string]openapi.PropertyDefinition{
+			"accuracy": {MetadataType: "MetadataDoubleValue"},
+			"stage":    {MetadataType: "MetadataStringValue", Enum: []string{"dev", "prod"}},
+			"tags":     {MetadataType: "MetadataListValue"},
+		},
+		Required: []string{"accuracy"},
+	})
+	assertion.Nil(err)
+
+	err = checkPropertySchema(&openapi.PropertySchema{
+		EntityType: apiutils.Of("ServeModel"),
+		Properties: &map[string]openapi.PropertyDefinition{
+			"_mr.digest": {MetadataType: "MetadataStringValue"},
+			"accuracy":   {MetadataType: "double"},
+			"config":     {MetadataType: "MetadataStructValue", Enum: []string{"{}"}},
+			"tags":       {MetadataType: "MetadataListValue", Enum: []string{"[]"}},
+		},
+		Required: []string{"owner"},
+	})
+	assertion.ErrorIs(err, api.ErrValidation)
+	assertion.Equal([]string{"entityType", "properties._mr.digest", "properties.accuracy.metadataType", "properties.config.enum", "properties.tags.enum", "required"}, fields(api.ErrToDetails(err)))
+}
+
+func TestWithPropertySchemas(t *testing.T) {
+	assertion := assert.New(t)
+	schema := openapi.PropertySchema{EntityType: apiutils.Of("ModelVersion"), RegisteredModelId: apiutils.Of("1")}
+
+	serv := &ModelRegistryService{}
+	assertion.Nil(WithPropertySchemas(schema)(serv))
+	assertion.Nil(serv.propertySchemas["ModelVersion"].RegisteredModelId, "global schemas are not attached to any model")
+
+	assertion.ErrorIs(WithPropertySchemas(schema, schema)(serv), api.ErrBadRequest)
+	assertion.ErrorIs(WithPropertySchemas(openapi.PropertySchema{})(serv), api.ErrValidation)
+}
+
+func TestCheckCustomProperties(t *testing.T) {
+	assertion := assert.New(t)
+	serv := &ModelRegistryService{}
+	assertion.Nil(WithPropertySchemas(openapi.PropertySchema{
+		EntityType: apiutils.Of("ModelVersion"),
+		Properties: &map[string]openapi.PropertyDefinition{
+			"accuracy": {MetadataType: "MetadataDoubleValue"},
+			"stage":    {MetadataType: "MetadataStringValue", Enum: []string{"dev", "prod"}},
+			"epochs":   {MetadataType: "MetadataIntValue", Enum: []string{"10", "20"}},
+		},
+		Required: []string{"stage"},
+		Strict:   apiutils.Of(true),
+	})(serv))
+	stage := func(value string) openapi.MetadataValue {
+		return openapi.MetadataValue{MetadataStringValue: converter.NewMetadataStringValue(value)}
+	}
+
+	// no schema for artifacts
+	assertion.Nil(serv.checkCustomProperties("ModelArtifact", nil, map[string]openapi.MetadataValue{"any": stage("x")}, nil))
+
+	assertion.Nil(serv.checkCustomProperties("ModelVersion", nil, map[string]openapi.MetadataValue{
+		"stage":        stage("dev"),
+		"epochs":       {MetadataIntValue: converter.NewMetadataIntValue("10")},
+		"_mr.internal": stage("x"),
+	}, nil))
+
+	err := serv.checkCustomProperties("ModelVersion", nil, map[string]openapi.MetadataValue{
+		"accuracy": stage("high"),
+		"epochs":   {MetadataIntValue: converter.NewMetadataIntValue("15")},
+		"owner":    stage("me"),
+	}, nil)
+	assertion.ErrorIs(err, api.ErrValidation)
+	assertion.Equal([]api.FieldError{
+		{Field: "customProperties.accuracy", Message: "must be a MetadataDoubleValue, not a MetadataStringValue"},
+		{Field: "customProperties.epochs", Message: "must be one of 10, 20"},
+		{Field: "customProperties.owner", Message: "is not defined in the property schema"},
+		{Field: "customProperties.stage", Message: "is required"},
+	}, api.ErrToDetails(err))
+
+	// unchanged properties are not checked again on updates
+	existing := map[string]openapi.MetadataValue{"stage": stage("dev"), "legacy": stage("x")}
+	assertion.Nil(serv.checkCustomProperties("ModelVersion", nil, existing, existing))
+	assertion.NotNil(serv.checkCustomProperties("ModelVersion", nil, map[string]openapi.MetadataValue{"stage": stage("test"), "legacy": stage("x")}, existing))
+	assertion.NotNil(serv.checkCustomProperties("ModelVersion", nil, map[string]openapi.MetadataValue{"legacy": stage("x")}, existing), "required properties can't be removed")
+
+	// schemas attached to the registered model replace the global one
+	registeredModel := &openapi.RegisteredModel{
+		Id: apiutils.Of("1"),
+		CustomProperties: &map[string]openapi.MetadataValue{
+			propertySchemaPrefix + "ModelVersion": stage(`{"properties":{"owner":{"metadataType":"MetadataStringValue"}}}`),
+		},
+	}
+	assertion.Nil(serv.checkCustomProperties("ModelVersion", registeredModel, map[string]openapi.MetadataValue{"owner": stage("me")}, nil))
+	schema, err := serv.propertySchemaFor("ModelVersion", registeredModel)
+	assertion.Nil(err)
+	assertion.Equal("1", *schema.RegisteredModelId)
+}
+
+func fields(details []api.FieldError) []string {
+	fields := make([]string, 0, len(details))
+	for _, d := range details {
+		fields = append(fields, d.Field)
+	}
+	return fields
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/converter"
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/kubeflow/model-registry/pkg/api"
+)
+
+// tenantRegexp restricts tenant names to DNS-label like strings, this also guarantees
+// they can be safely embedded in MLMD filter queries and entity names.
+var tenantRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+const (
+	// tenantProperty is the MLMD property holding the tenant owning an entity
+	tenantProperty  = "tenant"
+	maxTenantLength = 63
+)
+
+var _ api.TenantScopedApi = (*ModelRegistryService)(nil)
+
+// ForTenant returns a copy of the service whose reads and writes are confined to the provided tenant.
+// Entities created through the returned service are stamped with the tenant MLMD property, and
+// entities belonging to any other tenant are reported as not found.
+func (serv *ModelRegistryService) ForTenant(tenant string) (api.ModelRegistryApi, error) {
+	if len(tenant) > maxTenantLength || !tenantRegexp.MatchString(tenant) {
+		return nil, fmt.Errorf("invalid tenant %q, must be a lowercase RFC 1123 label: %w", tenant, api.ErrBadRequest)
+	}
+	scoped := *serv
+	scoped.tenant = tenant
+	return &scoped, nil
+}
+
+// tenantQuery combines the provided MLMD filter query with the tenant constraint, if any
+func (serv *ModelRegistryService) tenantQuery(query string) string {
+	if serv.tenant == "" {
+		return query
+	}
+	tenantQuery := fmt.Sprintf("properties.%s.string_value = \"%s\"", tenantProperty, serv.tenant)
+	if query == "" {
+		return tenantQuery
+	}
+	return fmt.Sprintf("%s and %s", query, tenantQuery)
+}
+
+// stampTenant sets the tenant property on the provided MLMD properties
+func (serv *ModelRegistryService) stampTenant(props map[string]*proto.Value) map[string]*proto.Value {
+	if serv.tenant == "" {
+		return props
+	}
+	if props == nil {
+		props = make(map[string]*proto.Value)
+	}
+	props[tenantProperty] = &proto.Value{
+		Value: &proto.Value_StringValue{
+			StringValue: serv.tenant,
+		},
+	}
+	return props
+}
+
+// ownedByTenant reports whether the MLMD entity having the provided properties is visible to the service tenant
+func (serv *ModelRegistryService) ownedByTenant(props map[string]*proto.Value) bool {
+	if serv.tenant == "" {
+		return true
+	}
+	return props[tenantProperty].GetStringValue() == serv.tenant
+}
+
+// tenantName maps a top level entity name to the MLMD one, names are prefixed by the tenant
+// so that they only need to be unique within each tenant
+func (serv *ModelRegistryService) tenantName(name *string) *string {
+	if serv.tenant == "" || name == nil {
+		return name
+	}
+	return apiutils.Of(converter.PrefixWhenOwned(&serv.tenant, *name))
+}
+
+// untenantName reverts tenantName, returning the user provided entity name
+func (serv *ModelRegistryService) untenantName(name *string) *string {
+	if serv.tenant == "" || name == nil {
+		return name
+	}
+	return apiutils.Of(strings.TrimPrefix(*name, serv.tenant+":"))
+}
@@ -0,0 +1,31 @@
+package core
+
+import (
+	"context"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans created by the core library
+const tracerName = "github.com/kubeflow/model-registry/pkg/core"
+
+var _ api.ContextualApi = (*ModelRegistryService)(nil)
+
+// WithContext returns a copy of the service propagating the provided context to every MLMD call,
+// spans of the core operations are created as children of the span carried by ctx, if any.
+func (serv *ModelRegistryService) WithContext(ctx context.Context) api.ModelRegistryApi {
+	scoped := *serv
+	scoped.ctx = ctx
+	return &scoped
+}
+
+// startSpan starts a span for the named core operation, returning a copy of the service carrying
+// the span context so that nested operations and MLMD calls are traced as its children.
+func (serv *ModelRegistryService) startSpan(operation string) (*ModelRegistryService, trace.Span) {
+	ctx, span := otel.Tracer(tracerName).Start(serv.ctx, "ModelRegistryService."+operation)
+	scoped := *serv
+	scoped.ctx = ctx
+	return &scoped, span
+}
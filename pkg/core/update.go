@@ -0,0 +1,70 @@
+package core
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Paths of the fields of MLMD nodes replaced when updating an entity, custom properties excluded: names are not
+// editable, and custom properties are updated one by one, see updateMask
+var (
+	contextUpdatePaths   = []string{"external_id", "properties"}
+	artifactUpdatePaths  = []string{"external_id", "uri", "state", "properties"}
+	executionUpdatePaths = []string{"external_id", "last_known_state", "properties"}
+)
+
+// mergeCustomProperties returns the custom properties of an entity being updated: the stored ones when properties is
+// nil, properties otherwise, plus the stored ones with the reserved prefix as these are managed by the registry.
+func mergeCustomProperties(properties *map[string]openapi.MetadataValue, stored map[string]openapi.MetadataValue) *map[string]openapi.MetadataValue {
+	merged := make(map[string]openapi.MetadataValue, len(stored))
+	if properties == nil {
+		for key, value := range stored {
+			merged[key] = value
+		}
+		return &merged
+	}
+	for key, value := range *properties {
+		merged[key] = value
+	}
+	for key, value := range stored {
+		if _, ok := merged[key]; !ok && strings.HasPrefix(key, reservedPrefix) {
+			merged[key] = value
+		}
+	}
+	return &merged
+}
+
+// updateMask returns the mask of the MLMD update of a stored entity, selecting the provided field paths and the custom
+// properties added, changed or removed from stored. Unchanged custom properties are dropped from node, the custom
+// properties of the MLMD node being updated, so that concurrent updates of other custom properties are preserved,
+// while removed ones are selected without a value so that MLMD deletes them.
+func updateMask(paths []string, node map[string]*proto.Value, properties, stored map[string]openapi.MetadataValue) *fieldmaskpb.FieldMask {
+	mask := &fieldmaskpb.FieldMask{Paths: append([]string{}, paths...)}
+	for key, value := range properties {
+		if previous, ok := stored[key]; ok && reflect.DeepEqual(previous, value) {
+			delete(node, key)
+			continue
+		}
+		mask.Paths = append(mask.Paths, "custom_properties."+key)
+	}
+	for key := range stored {
+		if _, ok := properties[key]; !ok {
+			mask.Paths = append(mask.Paths, "custom_properties."+key)
+		}
+	}
+	slices.Sort(mask.Paths[len(paths):])
+	return mask
+}
+
+// artifactCustomProperties returns the custom properties of the model or doc artifact
+func artifactCustomProperties(artifact *openapi.Artifact) map[string]openapi.MetadataValue {
+	if artifact.ModelArtifact != nil {
+		return artifact.ModelArtifact.GetCustomProperties()
+	}
+	return artifact.DocArtifact.GetCustomProperties()
+}
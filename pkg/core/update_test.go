@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/converter"
+	"github.com/kubeflow/model-registry/internal/ml_metadata/proto"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func stringValue(value string) openapi.MetadataValue {
+	return openapi.MetadataValue{MetadataStringValue: converter.NewMetadataStringValue(value)}
+}
+
+func TestMergeCustomProperties(t *testing.T) {
+	assertion := assert.New(t)
+	stored := map[string]openapi.MetadataValue{
+		"a":                                   stringValue("a"),
+		"b":                                   stringValue("b"),
+		propertySchemaPrefix + "ModelVersion": stringValue("{}"),
+	}
+
+	assertion.Equal(stored, *mergeCustomProperties(nil, stored), "nil custom properties keep the stored ones")
+	assertion.Equal(map[string]openapi.MetadataValue{
+		"a":                                   stringValue("A"),
+		propertySchemaPrefix + "ModelVersion": stringValue("{}"),
+	}, *mergeCustomProperties(&map[string]openapi.MetadataValue{"a": stringValue("A")}, stored))
+	assertion.Empty(*mergeCustomProperties(&map[string]openapi.MetadataValue{}, nil))
+}
+
+func TestUpdateMask(t *testing.T) {
+	assertion := assert.New(t)
+	stored := map[string]openapi.MetadataValue{
+		"unchanged": stringValue("x"),
+		"changed":   stringValue("x"),
+		"removed":   stringValue("x"),
+	}
+	properties := map[string]openapi.MetadataValue{
+		"unchanged": stringValue("x"),
+		"changed":   stringValue("y"),
+		"added":     stringValue("y"),
+	}
+	node := map[string]*proto.Value{
+		"unchanged": {Value: &proto.Value_StringValue{StringValue: "x"}},
+		"changed":   {Value: &proto.Value_StringValue{StringValue: "y"}},
+		"added":     {Value: &proto.Value_StringValue{StringValue: "y"}},
+	}
+
+	mask := updateMask(contextUpdatePaths, node, properties, stored)
+	assertion.Equal([]string{"external_id", "properties", "custom_properties.added", "custom_properties.changed", "custom_properties.removed"}, mask.Paths)
+	assertion.NotContains(node, "unchanged", "unchanged custom properties are not written")
+	assertion.Len(node, 2)
+	assertion.Equal([]string{"external_id", "properties"}, contextUpdatePaths, "paths are not modified")
+}
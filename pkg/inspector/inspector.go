@@ -0,0 +1,351 @@
+// Package inspector detects the format of a model from the headers and layout of its files, so that the
+// modelFormatName and modelFormatVersion of model artifacts, used by KServe to select a serving runtime, don't have to
+// be typed by hand, and reads the signature of the model when its format records one.
+package inspector
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// Names of the detected model formats, the ones of the KServe serving runtimes
+const (
+	FormatONNX        = "onnx"
+	FormatSafetensors = "safetensors"
+	FormatPyTorch     = "pytorch"
+	FormatTensorFlow  = "tensorflow"
+	FormatSklearn     = "sklearn"
+	FormatXGBoost     = "xgboost"
+	FormatGGUF        = "gguf"
+)
+
+// preference orders the formats detected in different files of an artifact: the first one found names the format of
+// the artifact, e.g. safetensors weights win over a PyTorch copy of them
+var preference = []string{FormatONNX, FormatGGUF, FormatSafetensors, FormatPyTorch, FormatXGBoost, FormatSklearn}
+
+// headerSize is the size of the file headers formats are detected from
+const headerSize = 4096
+
+// maxScanSize is the size of the start of files scanned for versions recorded within the model, e.g. by scikit-learn
+const maxScanSize = 64 << 20
+
+// candidates are the extensions of the files inspected, files without extension are inspected as well
+var candidates = map[string]bool{
+	".onnx": true, ".safetensors": true, ".pt": true, ".pth": true, ".bin": true, ".pkl": true, ".pickle": true,
+	".joblib": true, ".json": true, ".ubj": true, ".bst": true, ".model": true, ".gguf": true,
+}
+
+// Artifact gives access to the files of a model artifact
+type Artifact interface {
+	// Files returns the paths of the files of the artifact, relative to its uri and using / as separator, or the
+	// name of the file when the uri points to a single file
+	Files() []string
+	// Open opens the file at path
+	Open(path string) (io.ReadCloser, error)
+}
+
+// Inspect detects the format of the model in artifact, and reads its signature from ONNX models and TensorFlow
+// SavedModels. The returned inspection is empty when the format isn't recognized.
+func Inspect(artifact Artifact) (*openapi.ModelArtifactInspection, error) {
+	files := artifact.Files()
+	if savedModel, ok := savedModelFile(files); ok {
+		return inspectSavedModel(artifact, savedModel)
+	}
+
+	detected := map[string]*openapi.ModelArtifactInspection{}
+	for _, file := range files {
+		ext := strings.ToLower(path.Ext(file))
+		if ext != "" && !candidates[ext] {
+			continue
+		}
+		inspection, err := inspectFile(artifact, file)
+		if err != nil {
+			return nil, fmt.Errorf("error inspecting %s: %w", file, err)
+		}
+		if inspection != nil && detected[inspection.GetModelFormatName()] == nil {
+			inspection.File = apiutils.Of(file)
+			detected[inspection.GetModelFormatName()] = inspection
+		}
+	}
+	for _, format := range preference {
+		if inspection := detected[format]; inspection != nil {
+			return inspection, nil
+		}
+	}
+	return &openapi.ModelArtifactInspection{}, nil
+}
+
+// savedModelFile returns the saved_model.pb file of a TensorFlow SavedModel, at the root of the artifact or in a
+// version directory as expected by TensorFlow Serving
+func savedModelFile(files []string) (string, bool) {
+	found := ""
+	for _, file := range files {
+		dir, name := path.Split(file)
+		if name != "saved_model.pb" || strings.Count(dir, "/") > 1 {
+			continue
+		}
+		if found == "" || len(file) < len(found) {
+			found = file
+		}
+	}
+	return found, found != ""
+}
+
+// inspectFile detects the format of file from its header, returning nil when it isn't recognized
+func inspectFile(artifact Artifact, file string) (*openapi.ModelArtifactInspection, error) {
+	r, err := artifact.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	br := bufio.NewReaderSize(r, headerSize)
+	header, err := br.Peek(headerSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	ext := strings.ToLower(path.Ext(file))
+	switch {
+	case bytes.HasPrefix(header, []byte("GGUF")) && len(header) >= 8:
+		version := binary.LittleEndian.Uint32(header[4:8])
+		return inspection(FormatGGUF, strconv.FormatUint(uint64(version), 10)), nil
+	case ext == ".onnx" && len(header) > 0 && header[0] == 0x08:
+		// ModelProto starts with its ir_version field
+		signature, err := onnxSignature(br)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ONNX model: %w", err)
+		}
+		result := inspection(FormatONNX, "")
+		result.Signature = signature
+		return result, nil
+	case isSafetensors(header):
+		return inspection(FormatSafetensors, ""), nil
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		if isTorchArchive(header) {
+			return inspection(FormatPyTorch, ""), nil
+		}
+	case isPickle(header):
+		if bytes.HasPrefix(header, torchMagic) {
+			return inspection(FormatPyTorch, ""), nil
+		}
+		if isSklearnPickle(header) {
+			return inspection(FormatSklearn, majorVersion(sklearnVersion(br))), nil
+		}
+	case bytes.HasPrefix(header, []byte("binf")):
+		return inspection(FormatXGBoost, ""), nil
+	case isXGBoostJSON(header):
+		return inspection(FormatXGBoost, majorVersion(xgboostJSONVersion(br))), nil
+	case ext == ".ubj" && isXGBoostUBJSON(header):
+		return inspection(FormatXGBoost, majorVersion(xgboostUBJSONVersion(br))), nil
+	}
+	return nil, nil
+}
+
+func inspection(format string, version string) *openapi.ModelArtifactInspection {
+	inspection := &openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of(format)}
+	if version != "" {
+		inspection.ModelFormatVersion = apiutils.Of(version)
+	}
+	return inspection
+}
+
+// isSafetensors checks for the little endian size of the JSON header of safetensors files, followed by the header
+func isSafetensors(header []byte) bool {
+	if len(header) < 9 {
+		return false
+	}
+	size := binary.LittleEndian.Uint64(header[:8])
+	return size > 1 && size < 100<<20 && header[8] == '{'
+}
+
+// isTorchArchive checks whether the first entry of a zip archive is the pickle of a model saved by torch.save or
+// torch.jit.save, e.g. archive/data.pkl
+func isTorchArchive(header []byte) bool {
+	if len(header) < 30 {
+		return false
+	}
+	nameLength := int(binary.LittleEndian.Uint16(header[26:28]))
+	if len(header) < 30+nameLength {
+		return false
+	}
+	name := string(header[30 : 30+nameLength])
+	return path.Base(name) == "data.pkl" || path.Base(name) == "constants.pkl" || strings.HasSuffix(path.Dir(name), "/code")
+}
+
+// isPickle checks for the PROTO opcode starting pickles of protocol 2 and up
+func isPickle(header []byte) bool {
+	return len(header) >= 2 && header[0] == 0x80 && header[1] >= 2 && header[1] <= 5
+}
+
+// torchMagic starts the legacy, non zip, serialization of torch.save: the pickle of its magic number
+var torchMagic = []byte("\x80\x02\x8a\x0a\x6c\xfc\x9c\x46\xf9\x20\x6a\xa8\x50\x19")
+
+// isSklearnPickle checks whether the pickle header references a class of a sklearn module, excluding the modules of
+// other libraries ending with sklearn, e.g. xgboost.sklearn
+func isSklearnPickle(header []byte) bool {
+	for i := 0; ; {
+		found := bytes.Index(header[i:], []byte("sklearn."))
+		if found < 0 {
+			return false
+		}
+		i += found
+		// module names follow either the length of a string or the GLOBAL opcode c
+		start := i
+		if start > 0 && header[start-1] == 'c' {
+			start--
+		}
+		if start == 0 || !isIdentifier(header[start-1]) && header[start-1] != '.' {
+			return true
+		}
+		i++
+	}
+}
+
+func isIdentifier(b byte) bool {
+	return b == '_' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+// sklearnVersion reads the version scikit-learn records in the state of pickled estimators, as the string value
+// following the _sklearn_version key, empty when not found
+func sklearnVersion(r io.Reader) string {
+	value, ok := scan(r, []byte("_sklearn_version"), 16)
+	if !ok {
+		return ""
+	}
+	// skip the opcode memoizing the key
+	switch {
+	case len(value) > 0 && value[0] == 0x94:
+		value = value[1:]
+	case len(value) > 1 && value[0] == 'q':
+		value = value[2:]
+	case len(value) > 4 && value[0] == 'r':
+		value = value[5:]
+	}
+	if len(value) < 2 {
+		return ""
+	}
+	switch value[0] {
+	case 0x8c, 'U': // SHORT_BINUNICODE, SHORT_BINSTRING
+		return prefix(value[2:], int(value[1]))
+	case 'X', 'T': // BINUNICODE, BINSTRING
+		if len(value) < 5 {
+			return ""
+		}
+		return prefix(value[5:], int(binary.LittleEndian.Uint32(value[1:5])))
+	}
+	return ""
+}
+
+func prefix(value []byte, n int) string {
+	if n > len(value) {
+		return ""
+	}
+	return string(value[:n])
+}
+
+// isXGBoostJSON checks whether the header is the one of a JSON object with a learner, as saved by XGBoost
+func isXGBoostJSON(header []byte) bool {
+	trimmed := bytes.TrimLeft(header, " \t\r\n")
+	return bytes.HasPrefix(trimmed, []byte("{")) && bytes.Contains(header, []byte(`"learner"`))
+}
+
+// xgboostJSONVersion reads the version XGBoost records in JSON models, e.g. "version": [2, 0, 3]
+func xgboostJSONVersion(r io.Reader) string {
+	value, ok := scan(r, []byte(`"version"`), 16)
+	if !ok {
+		return ""
+	}
+	value = bytes.TrimLeft(value, " \t\r\n:[")
+	end := 0
+	for end < len(value) && value[end] >= '0' && value[end] <= '9' {
+		end++
+	}
+	return string(value[:end])
+}
+
+// isXGBoostUBJSON checks whether the header is the one of a UBJSON object with a learner, as saved by XGBoost
+func isXGBoostUBJSON(header []byte) bool {
+	return bytes.HasPrefix(header, []byte("{")) && bytes.Contains(header, []byte("learner"))
+}
+
+// xgboostUBJSONVersion reads the major version XGBoost records in UBJSON models, an array of integers
+func xgboostUBJSONVersion(r io.Reader) string {
+	value, ok := scan(r, []byte("version["), 16)
+	if !ok {
+		return ""
+	}
+	// optimized arrays declare the type of their elements and their count first
+	typ := byte(0)
+	if len(value) > 1 && value[0] == '$' {
+		typ, value = value[1], value[2:]
+		if len(value) < 2 || value[0] != '#' {
+			return ""
+		}
+		countSize := map[byte]int{'i': 1, 'U': 1, 'I': 2, 'l': 4, 'L': 8}[value[1]]
+		if countSize == 0 || len(value) < 2+countSize {
+			return ""
+		}
+		value = value[2+countSize:]
+	} else if len(value) > 0 {
+		typ, value = value[0], value[1:]
+	}
+	switch {
+	case typ == 'i' && len(value) >= 1:
+		return strconv.Itoa(int(int8(value[0])))
+	case typ == 'U' && len(value) >= 1:
+		return strconv.Itoa(int(value[0]))
+	case typ == 'I' && len(value) >= 2:
+		return strconv.Itoa(int(int16(binary.BigEndian.Uint16(value))))
+	case typ == 'l' && len(value) >= 4:
+		return strconv.Itoa(int(int32(binary.BigEndian.Uint32(value))))
+	case typ == 'L' && len(value) >= 8:
+		return strconv.FormatInt(int64(binary.BigEndian.Uint64(value)), 10)
+	}
+	return ""
+}
+
+// majorVersion returns the major version of version, empty when version is
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
+
+// scan reads r, up to maxScanSize, until marker and returns up to n of the bytes following it
+func scan(r io.Reader, marker []byte, n int) ([]byte, bool) {
+	r = io.LimitReader(r, maxScanSize)
+	buf := make([]byte, 0, 64<<10)
+	chunk := make([]byte, 32<<10)
+	for {
+		read, err := r.Read(chunk)
+		buf = append(buf, chunk[:read]...)
+		if i := bytes.Index(buf, marker); i >= 0 {
+			value := buf[i+len(marker):]
+			// read the rest of the value if needed
+			for len(value) < n && err == nil {
+				read, err = r.Read(chunk)
+				value = append(value, chunk[:read]...)
+			}
+			if len(value) > n {
+				value = value[:n]
+			}
+			return value, true
+		}
+		if err != nil {
+			return nil, false
+		}
+		// keep the end of the buffer, which may hold the start of the marker
+		if keep := len(marker) - 1; len(buf) > keep {
+			buf = append(buf[:0], buf[len(buf)-keep:]...)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package inspector
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// files is an artifact of files in memory, by path
+type files map[string][]byte
+
+func (f files) Files() []string {
+	paths := []string{}
+	for file := range f {
+		paths = append(paths, file)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (f files) Open(path string) (io.ReadCloser, error) {
+	content, ok := f[path]
+	if !ok {
+		return nil, fmt.Errorf("no file %s", path)
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+// message builds a protobuf message from its fields, each built by an append function
+func message(fields ...func([]byte) []byte) []byte {
+	b := []byte{}
+	for _, field := range fields {
+		b = field(b)
+	}
+	return b
+}
+
+func bytesField(num protowire.Number, value []byte) func([]byte) []byte {
+	return func(b []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, value)
+	}
+}
+
+func stringField(num protowire.Number, value string) func([]byte) []byte {
+	return bytesField(num, []byte(value))
+}
+
+func varintField(num protowire.Number, value int64) func([]byte) []byte {
+	return func(b []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return protowire.AppendVarint(b, uint64(value))
+	}
+}
+
+// onnxValueInfo builds a ValueInfoProto of a tensor, dimensions of size -1 are named
+func onnxValueInfo(name string, elemType int64, shape ...int64) []byte {
+	dims := []func([]byte) []byte{}
+	for _, size := range shape {
+		if size < 0 {
+			dims = append(dims, bytesField(1, message(stringField(2, "batch"))))
+		} else {
+			dims = append(dims, bytesField(1, message(varintField(1, size))))
+		}
+	}
+	tensorType := message(varintField(1, elemType), bytesField(2, message(dims...)))
+	return message(stringField(1, name), bytesField(2, message(bytesField(1, tensorType))))
+}
+
+func onnxModel() []byte {
+	graph := message(
+		bytesField(1, message(stringField(1, "MatMul"))),
+		// initializer listed as an input, with raw data
+		bytesField(5, message(varintField(1, 10), varintField(2, 1), stringField(8, "weights"), bytesField(9, make([]byte, 40)))),
+		stringField(2, "graph"),
+		bytesField(11, onnxValueInfo("image", 1, -1, 3, 224, 224)),
+		bytesField(11, onnxValueInfo("weights", 1, 10)),
+		bytesField(12, onnxValueInfo("scores", 1, -1, 10)),
+		bytesField(12, onnxValueInfo("label", 7, -1)),
+	)
+	return message(varintField(1, 8), stringField(2, "pytorch"), bytesField(8, message(varintField(2, 17))), bytesField(7, graph))
+}
+
+// tensorInfo builds a TensorInfo of a SignatureDef
+func tensorInfo(dtype int64, shape ...int64) []byte {
+	dims := []func([]byte) []byte{}
+	for _, size := range shape {
+		dims = append(dims, bytesField(2, message(varintField(1, size))))
+	}
+	return message(stringField(1, "serving_default_x:0"), varintField(2, dtype), bytesField(3, message(dims...)))
+}
+
+func savedModel(version string) []byte {
+	signature := message(
+		bytesField(1, message(stringField(1, "text"), bytesField(2, tensorInfo(7, -1)))),
+		bytesField(1, message(stringField(1, "features"), bytesField(2, tensorInfo(1, -1, 20)))),
+		bytesField(2, message(stringField(1, "probabilities"), bytesField(2, tensorInfo(1, -1, 2)))),
+		stringField(3, "tensorflow/serving/predict"),
+	)
+	metaGraph := message(
+		bytesField(1, message(stringField(4, "serve"), stringField(5, version))),
+		bytesField(2, make([]byte, 1000)),
+		bytesField(5, message(stringField(1, "__saved_model_init_op"), bytesField(2, message()))),
+		bytesField(5, message(stringField(1, servingSignature), bytesField(2, signature))),
+	)
+	return message(varintField(1, 1), bytesField(2, metaGraph))
+}
+
+func safetensors() []byte {
+	header := `{"weight":{"dtype":"F32","shape":[2],"data_offsets":[0,8]}}`
+	b := binary.LittleEndian.AppendUint64(nil, uint64(len(header)))
+	return append(append(b, header...), make([]byte, 8)...)
+}
+
+func torchArchive(t *testing.T) []byte {
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	for _, name := range []string{"archive/data.pkl", "archive/data/0", "archive/version"} {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("\x80\x02}q\x00."))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return b.Bytes()
+}
+
+// sklearnPickle is the start of a pickle of a LogisticRegression, of protocol 4 and 2, with its state
+var sklearnPickle = []byte("\x80\x04\x95\x10\x02\x00\x00\x00\x00\x00\x00\x8c\x1esklearn.linear_model._logistic\x94\x8c\x12LogisticRegression\x94\x93\x94)\x81\x94}\x94(\x8c\x07penalty\x94\x8c\x02l2\x94\x8c\x10_sklearn_version\x94\x8c\x051.4.2\x94ub.")
+var sklearnPickle2 = []byte("\x80\x02csklearn.linear_model._logistic\nLogisticRegression\nq\x00)\x81q\x01}q\x02(X\x07\x00\x00\x00penaltyq\x03X\x02\x00\x00\x00l2q\x04X\x10\x00\x00\x00_sklearn_versionq\x05X\x06\x00\x00\x000.24.2q\x06ub.")
+
+// xgboostSklearnPickle pickles a model of the scikit-learn API of XGBoost, which isn't a scikit-learn model
+var xgboostSklearnPickle = []byte("\x80\x04\x95\x10\x02\x00\x00\x00\x00\x00\x00\x8c\x0fxgboost.sklearn\x94\x8c\rXGBClassifier\x94\x93\x94)\x81\x94.")
+
+func gguf() []byte {
+	b := []byte("GGUF")
+	b = binary.LittleEndian.AppendUint32(b, 3)
+	return binary.LittleEndian.AppendUint64(b, 291)
+}
+
+func TestInspect(t *testing.T) {
+	testCases := []struct {
+		name       string
+		artifact   files
+		inspection openapi.ModelArtifactInspection
+	}{
+		{
+			name:     "onnx",
+			artifact: files{"model.onnx": onnxModel()},
+			inspection: openapi.ModelArtifactInspection{
+				ModelFormatName: apiutils.Of("onnx"),
+				Signature: &openapi.ModelSignature{
+					Inputs: []openapi.TensorSpec{{Name: "image", Dtype: "float32", Shape: []int64{-1, 3, 224, 224}}},
+					Outputs: []openapi.TensorSpec{
+						{Name: "scores", Dtype: "float32", Shape: []int64{-1, 10}},
+						{Name: "label", Dtype: "int64", Shape: []int64{-1}},
+					},
+				},
+				File: apiutils.Of("model.onnx"),
+			},
+		},
+		{
+			name:     "tensorflow",
+			artifact: files{"1/saved_model.pb": savedModel("2.15.0"), "1/variables/variables.index": []byte("index"), "1/variables/variables.data-00000-of-00001": []byte("data")},
+			inspection: openapi.ModelArtifactInspection{
+				ModelFormatName:    apiutils.Of("tensorflow"),
+				ModelFormatVersion: apiutils.Of("2"),
+				Signature: &openapi.ModelSignature{
+					Inputs: []openapi.TensorSpec{
+						{Name: "features", Dtype: "float32", Shape: []int64{-1, 20}},
+						{Name: "text", Dtype: "string", Shape: []int64{-1}},
+					},
+					Outputs: []openapi.TensorSpec{{Name: "probabilities", Dtype: "float32", Shape: []int64{-1, 2}}},
+				},
+				File: apiutils.Of("1/saved_model.pb"),
+			},
+		},
+		{
+			name:       "safetensors preferred to pytorch",
+			artifact:   files{"config.json": []byte(`{"architectures": ["BertModel"]}`), "model.safetensors": safetensors(), "pytorch_model.bin": torchArchive(t), "README.md": []byte("# BERT")},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("safetensors"), File: apiutils.Of("model.safetensors")},
+		},
+		{
+			name:       "pytorch",
+			artifact:   files{"model.pt": torchArchive(t)},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("pytorch"), File: apiutils.Of("model.pt")},
+		},
+		{
+			name:       "legacy pytorch",
+			artifact:   files{"model.pth": append(append([]byte{}, torchMagic...), ".\x80\x02M\xe9\x03."...)},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("pytorch"), File: apiutils.Of("model.pth")},
+		},
+		{
+			name:       "sklearn",
+			artifact:   files{"model.joblib": sklearnPickle},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("sklearn"), ModelFormatVersion: apiutils.Of("1"), File: apiutils.Of("model.joblib")},
+		},
+		{
+			name:       "sklearn protocol 2",
+			artifact:   files{"model.pkl": sklearnPickle2},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("sklearn"), ModelFormatVersion: apiutils.Of("0"), File: apiutils.Of("model.pkl")},
+		},
+		{
+			name:       "xgboost json",
+			artifact:   files{"model.json": []byte(`{"learner": {"attributes": {}, "objective": {"name": "binary:logistic"}}, "version": [2, 0, 3]}`)},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("xgboost"), ModelFormatVersion: apiutils.Of("2"), File: apiutils.Of("model.json")},
+		},
+		{
+			name:       "xgboost ubjson",
+			artifact:   files{"model.ubj": []byte("{L\x00\x00\x00\x00\x00\x00\x00\x07learner{}L\x00\x00\x00\x00\x00\x00\x00\x07version[$i#i\x03\x01\x07\x06}")},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("xgboost"), ModelFormatVersion: apiutils.Of("1"), File: apiutils.Of("model.ubj")},
+		},
+		{
+			name:       "xgboost binary",
+			artifact:   files{"model.bst": []byte("binf\x00\x00\x00\x3f")},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("xgboost"), File: apiutils.Of("model.bst")},
+		},
+		{
+			name:       "gguf",
+			artifact:   files{"llama-q4_0.gguf": gguf()},
+			inspection: openapi.ModelArtifactInspection{ModelFormatName: apiutils.Of("gguf"), ModelFormatVersion: apiutils.Of("3"), File: apiutils.Of("llama-q4_0.gguf")},
+		},
+		{
+			name:       "unknown",
+			artifact:   files{"model.pkl": xgboostSklearnPickle, "labels.txt": []byte("cat\ndog\n"), "model.h5": []byte("\x89HDF\r\n\x1a\n")},
+			inspection: openapi.ModelArtifactInspection{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inspection, err := Inspect(tc.artifact)
+			require.NoError(t, err)
+			assert.Equal(t, tc.inspection, *inspection)
+		})
+	}
+}
+
+func TestInspectInvalid(t *testing.T) {
+	model := onnxModel()
+	_, err := Inspect(files{"model.onnx": model[:len(model)-10]})
+	assert.ErrorContains(t, err, "error inspecting model.onnx: invalid ONNX model")
+
+	_, err = Inspect(files{"saved_model.pb": append(savedModel("1.15.0"), 0x12, 0x7f)})
+	assert.ErrorContains(t, err, "error inspecting saved_model.pb: invalid SavedModel")
+}
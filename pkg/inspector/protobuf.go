@@ -0,0 +1,160 @@
+package inspector
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// maxFieldSize is the size of the largest protobuf field read in memory, e.g. the definition of an input
+const maxFieldSize = 16 << 20
+
+// protoStream reads the fields of a protobuf message from a stream, without reading in memory the ones skipped, so
+// that the signature of models is read without loading their weights
+type protoStream struct {
+	r   *bufio.Reader
+	pos int64
+}
+
+func newProtoStream(r io.Reader) *protoStream {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &protoStream{r: br}
+}
+
+// protoField is a field read from a protoStream
+type protoField struct {
+	num protowire.Number
+	typ protowire.Type
+	// value of varint fields
+	v uint64
+	// end of the value of length delimited fields in the stream
+	end int64
+}
+
+func (s *protoStream) ReadByte() (byte, error) {
+	b, err := s.r.ReadByte()
+	if err == nil {
+		s.pos++
+	}
+	return b, err
+}
+
+// fields calls fn with the fields of the message ending at end, -1 for the end of the stream. fn may read the value
+// of length delimited fields with bytes, or their fields with fields, the values left are skipped.
+func (s *protoStream) fields(end int64, fn func(f protoField) error) error {
+	for end < 0 || s.pos < end {
+		tag, err := binary.ReadUvarint(s)
+		if errors.Is(err, io.EOF) && end < 0 {
+			return nil
+		}
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		num, typ := protowire.DecodeTag(tag)
+		f := protoField{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.v, err = binary.ReadUvarint(s)
+		case protowire.Fixed32Type:
+			err = s.discard(4)
+		case protowire.Fixed64Type:
+			err = s.discard(8)
+		case protowire.BytesType:
+			var size uint64
+			size, err = binary.ReadUvarint(s)
+			if err == nil && (size > 1<<40 || end >= 0 && s.pos+int64(size) > end) {
+				err = fmt.Errorf("field %d of size %d overflows its message", num, size)
+			}
+			f.end = s.pos + int64(size)
+		default:
+			err = fmt.Errorf("unsupported wire type %d of field %d", typ, num)
+		}
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		if err := fn(f); err != nil {
+			return err
+		}
+		if typ == protowire.BytesType {
+			if err := s.discard(f.end - s.pos); err != nil {
+				return unexpectedEOF(err)
+			}
+		}
+	}
+	if s.pos != end {
+		return fmt.Errorf("message overflows its end at %d", end)
+	}
+	return nil
+}
+
+// bytes reads the value of the length delimited field f
+func (s *protoStream) bytes(f protoField) ([]byte, error) {
+	size := f.end - s.pos
+	if size > maxFieldSize {
+		return nil, fmt.Errorf("field %d of size %d is too large", f.num, size)
+	}
+	value := make([]byte, size)
+	n, err := io.ReadFull(s.r, value)
+	s.pos += int64(n)
+	return value, unexpectedEOF(err)
+}
+
+func (s *protoStream) discard(n int64) error {
+	for n > 0 {
+		chunk := n
+		if chunk > 1<<30 {
+			chunk = 1 << 30
+		}
+		discarded, err := s.r.Discard(int(chunk))
+		s.pos += int64(discarded)
+		n -= int64(discarded)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// eachField calls fn with the fields of the message b: their value v for varint fields, or their bytes for length
+// delimited fields
+func eachField(b []byte, fn func(num protowire.Number, v uint64, value []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		var v uint64
+		var value []byte
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if err := fn(num, v, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+package inspector
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// onnxTypes maps the ONNX TensorProto.DataType values to the data types of tensor specs
+var onnxTypes = map[uint64]string{
+	1: "float32", 2: "uint8", 3: "int8", 4: "uint16", 5: "int16", 6: "int32", 7: "int64", 8: "string", 9: "bool",
+	10: "float16", 11: "float64", 12: "uint32", 13: "uint64", 14: "complex64", 15: "complex128", 16: "bfloat16",
+}
+
+// tensorflowTypes maps the TensorFlow DataType values to the data types of tensor specs
+var tensorflowTypes = map[uint64]string{
+	1: "float32", 2: "float64", 3: "int32", 4: "uint8", 5: "int16", 6: "int8", 7: "string", 8: "complex64", 9: "int64",
+	10: "bool", 14: "bfloat16", 17: "uint16", 18: "complex128", 19: "float16", 22: "uint32", 23: "uint64",
+}
+
+// unknownType is the data type of tensor specs whose type isn't a tensor one, e.g. ONNX sequences
+const unknownType = "unknown"
+
+// servingSignature is the name of the signature TensorFlow Serving serves by default
+const servingSignature = "serving_default"
+
+// onnxSignature reads the inputs and outputs of the graph of the ONNX ModelProto read by r, excluding the
+// initializers listed as inputs by models of IR version 3 and before
+func onnxSignature(r io.Reader) (*openapi.ModelSignature, error) {
+	s := newProtoStream(r)
+	var inputs, outputs []openapi.TensorSpec
+	initializers := map[string]bool{}
+	err := s.fields(-1, func(f protoField) error {
+		if f.num != 7 || f.typ != protowire.BytesType { // ModelProto.graph
+			return nil
+		}
+		return s.fields(f.end, func(f protoField) error {
+			if f.typ != protowire.BytesType {
+				return nil
+			}
+			switch f.num {
+			case 5: // GraphProto.initializer
+				return s.fields(f.end, func(f protoField) error {
+					if f.num != 8 || f.typ != protowire.BytesType { // TensorProto.name
+						return nil
+					}
+					name, err := s.bytes(f)
+					initializers[string(name)] = true
+					return err
+				})
+			case 11, 12: // GraphProto.input, GraphProto.output
+				value, err := s.bytes(f)
+				if err != nil {
+					return err
+				}
+				spec, err := onnxTensorSpec(value)
+				if err != nil {
+					return err
+				}
+				if f.num == 11 {
+					inputs = append(inputs, spec)
+				} else {
+					outputs = append(outputs, spec)
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	signature := &openapi.ModelSignature{Outputs: outputs}
+	for _, input := range inputs {
+		if !initializers[input.Name] {
+			signature.Inputs = append(signature.Inputs, input)
+		}
+	}
+	return signature, nil
+}
+
+// onnxTensorSpec reads a ValueInfoProto, dimensions without value, e.g. named batch dimensions, have size -1
+func onnxTensorSpec(valueInfo []byte) (openapi.TensorSpec, error) {
+	spec := openapi.TensorSpec{Dtype: unknownType}
+	err := eachField(valueInfo, func(num protowire.Number, _ uint64, value []byte) error {
+		switch num {
+		case 1: // name
+			spec.Name = string(value)
+		case 2: // type
+			return eachField(value, func(num protowire.Number, _ uint64, value []byte) error {
+				if num != 1 { // TypeProto.tensor_type
+					return nil
+				}
+				return eachField(value, func(num protowire.Number, v uint64, value []byte) error {
+					switch num {
+					case 1: // elem_type
+						spec.Dtype = dataType(onnxTypes, v)
+					case 2: // shape
+						spec.Shape = []int64{}
+						return eachField(value, func(num protowire.Number, _ uint64, value []byte) error {
+							if num != 1 { // TensorShapeProto.dim
+								return nil
+							}
+							size := int64(-1)
+							err := eachField(value, func(num protowire.Number, v uint64, _ []byte) error {
+								if num == 1 { // dim_value
+									size = int64(v)
+								}
+								return nil
+							})
+							spec.Shape = append(spec.Shape, size)
+							return err
+						})
+					}
+					return nil
+				})
+			})
+		}
+		return nil
+	})
+	return spec, err
+}
+
+// inspectSavedModel reads the TensorFlow version and the serving signature of the serve MetaGraphDef of the SavedModel
+// in file
+func inspectSavedModel(artifact Artifact, file string) (*openapi.ModelArtifactInspection, error) {
+	r, err := artifact.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var version string
+	var signature *openapi.ModelSignature
+	served := false
+	s := newProtoStream(bufio.NewReader(r))
+	err = s.fields(-1, func(f protoField) error {
+		if f.num != 2 || f.typ != protowire.BytesType || served { // SavedModel.meta_graphs
+			return nil
+		}
+		var graphVersion string
+		var graphSignature *openapi.ModelSignature
+		signatures := map[string][]byte{}
+		err := s.fields(f.end, func(f protoField) error {
+			if f.typ != protowire.BytesType {
+				return nil
+			}
+			switch f.num {
+			case 1: // MetaGraphDef.meta_info_def
+				return s.fields(f.end, func(f protoField) error {
+					if f.typ != protowire.BytesType {
+						return nil
+					}
+					switch f.num {
+					case 4: // tags
+						tag, err := s.bytes(f)
+						served = served || string(tag) == "serve"
+						return err
+					case 5: // tensorflow_version
+						value, err := s.bytes(f)
+						graphVersion = string(value)
+						return err
+					}
+					return nil
+				})
+			case 5: // MetaGraphDef.signature_def, map entries
+				value, err := s.bytes(f)
+				if err != nil {
+					return err
+				}
+				key, def, err := mapEntry(value)
+				signatures[key] = def
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		def, ok := signatures[servingSignature]
+		if !ok && len(signatures) == 1 {
+			for _, only := range signatures {
+				def = only
+			}
+		}
+		if def != nil {
+			if graphSignature, err = tensorflowSignature(def); err != nil {
+				return err
+			}
+		}
+		if signature == nil || served {
+			version, signature = graphVersion, graphSignature
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error inspecting %s: invalid SavedModel: %w", file, err)
+	}
+	result := inspection(FormatTensorFlow, majorVersion(version))
+	result.File = apiutils.Of(file)
+	result.Signature = signature
+	return result, nil
+}
+
+// tensorflowSignature reads the inputs and outputs of a SignatureDef, ordered by name
+func tensorflowSignature(def []byte) (*openapi.ModelSignature, error) {
+	signature := &openapi.ModelSignature{}
+	err := eachField(def, func(num protowire.Number, _ uint64, value []byte) error {
+		if num != 1 && num != 2 { // inputs, outputs
+			return nil
+		}
+		name, info, err := mapEntry(value)
+		if err != nil {
+			return err
+		}
+		spec := openapi.TensorSpec{Name: name, Dtype: unknownType}
+		err = eachField(info, func(num protowire.Number, v uint64, value []byte) error {
+			switch num {
+			case 2: // TensorInfo.dtype
+				spec.Dtype = dataType(tensorflowTypes, v)
+			case 3: // TensorInfo.tensor_shape
+				return eachField(value, func(num protowire.Number, v uint64, value []byte) error {
+					switch num {
+					case 2: // TensorShapeProto.dim
+						size := int64(-1)
+						err := eachField(value, func(num protowire.Number, v uint64, _ []byte) error {
+							if num == 1 { // size
+								size = int64(v)
+							}
+							return nil
+						})
+						spec.Shape = append(spec.Shape, size)
+						return err
+					case 3: // unknown_rank
+						if v != 0 {
+							spec.Shape = nil
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		})
+		if num == 1 {
+			signature.Inputs = append(signature.Inputs, spec)
+		} else {
+			signature.Outputs = append(signature.Outputs, spec)
+		}
+		return err
+	})
+	sortSpecs(signature.Inputs)
+	sortSpecs(signature.Outputs)
+	return signature, err
+}
+
+// mapEntry reads the string key and the message value of a protobuf map entry
+func mapEntry(entry []byte) (string, []byte, error) {
+	var key string
+	value := []byte{}
+	err := eachField(entry, func(num protowire.Number, _ uint64, v []byte) error {
+		switch num {
+		case 1:
+			key = string(v)
+		case 2:
+			value = v
+		}
+		return nil
+	})
+	return key, value, err
+}
+
+func dataType(types map[uint64]string, v uint64) string {
+	if dtype, ok := types[v]; ok {
+		return dtype
+	}
+	return unknownType
+}
+
+func sortSpecs(specs []openapi.TensorSpec) {
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].Name < specs[j].Name
+	})
+}
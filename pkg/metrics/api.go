@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+const (
+	operationCreate = "create"
+	operationUpdate = "update"
+)
+
+// instrumentedApi decorates a ModelRegistryApi counting the entities created and updated through it,
+// read operations are forwarded as they are to the embedded implementation.
+type instrumentedApi struct {
+	api.ModelRegistryApi
+	metrics *Metrics
+}
+
+// InstrumentApi returns a ModelRegistryApi counting the entities created and updated through the provided one.
+// The returned api keeps supporting multi-tenancy if the decorated one does.
+func (m *Metrics) InstrumentApi(delegate api.ModelRegistryApi) api.ModelRegistryApi {
+	return &instrumentedApi{
+		ModelRegistryApi: delegate,
+		metrics:          m,
+	}
+}
+
+// ForTenant forwards to the decorated api, instrumenting the tenant scoped one as well
+func (i *instrumentedApi) ForTenant(tenant string) (api.ModelRegistryApi, error) {
+	scoped, ok := i.ModelRegistryApi.(api.TenantScopedApi)
+	if !ok {
+		return nil, fmt.Errorf("multi-tenancy is not supported by the decorated api")
+	}
+	delegate, err := scoped.ForTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	return i.metrics.InstrumentApi(delegate), nil
+}
+
+// WithContext forwards to the decorated api, the returned api is instrumented as well
+func (i *instrumentedApi) WithContext(ctx context.Context) api.ModelRegistryApi {
+	contextual, ok := i.ModelRegistryApi.(api.ContextualApi)
+	if !ok {
+		return i
+	}
+	return i.metrics.InstrumentApi(contextual.WithContext(ctx))
+}
+
+// record increments the entity operations counter when the upsert succeeded
+func (i *instrumentedApi) record(entityType string, id *string, err error) {
+	if err != nil {
+		return
+	}
+	operation := operationCreate
+	if id != nil {
+		operation = operationUpdate
+	}
+	i.metrics.entityOperations.WithLabelValues(entityType, operation).Inc()
+}
+
+func (i *instrumentedApi) UpsertRegisteredModel(registeredModel *openapi.RegisteredModel) (*openapi.RegisteredModel, error) {
+	id, _ := registeredModel.GetIdOk()
+	result, err := i.ModelRegistryApi.UpsertRegisteredModel(registeredModel)
+	i.record("RegisteredModel", id, err)
+	return result, err
+}
+
+func (i *instrumentedApi) UpsertModelVersion(modelVersion *openapi.ModelVersion, registeredModelId *string) (*openapi.ModelVersion, error) {
+	id, _ := modelVersion.GetIdOk()
+	result, err := i.ModelRegistryApi.UpsertModelVersion(modelVersion, registeredModelId)
+	i.record("ModelVersion", id, err)
+	return result, err
+}
+
+func (i *instrumentedApi) UpsertArtifact(artifact *openapi.Artifact, modelVersionId *string) (*openapi.Artifact, error) {
+	entityType := "Artifact"
+	var id *string
+	if artifact != nil {
+		if ma := artifact.ModelArtifact; ma != nil {
+			entityType = "ModelArtifact"
+			id, _ = ma.GetIdOk()
+		} else if da := artifact.DocArtifact; da != nil {
+			entityType = "DocArtifact"
+			id, _ = da.GetIdOk()
+		}
+	}
+	result, err := i.ModelRegistryApi.UpsertArtifact(artifact, modelVersionId)
+	i.record(entityType, id, err)
+	return result, err
+}
+
+func (i *instrumentedApi) UpsertModelArtifact(modelArtifact *openapi.ModelArtifact, modelVersionId *string) (*openapi.ModelArtifact, error) {
+	id, _ := modelArtifact.GetIdOk()
+	result, err := i.ModelRegistryApi.UpsertModelArtifact(modelArtifact, modelVersionId)
+	i.record("ModelArtifact", id, err)
+	return result, err
+}
+
+func (i *instrumentedApi) UpsertServingEnvironment(servingEnvironment *openapi.ServingEnvironment) (*openapi.ServingEnvironment, error) {
+	id, _ := servingEnvironment.GetIdOk()
+	result, err := i.ModelRegistryApi.UpsertServingEnvironment(servingEnvironment)
+	i.record("ServingEnvironment", id, err)
+	return result, err
+}
+
+func (i *instrumentedApi) UpsertInferenceService(inferenceService *openapi.InferenceService) (*openapi.InferenceService, error) {
+	id, _ := inferenceService.GetIdOk()
+	result, err := i.ModelRegistryApi.UpsertInferenceService(inferenceService)
+	i.record("InferenceService", id, err)
+	return result, err
+}
+
+func (i *instrumentedApi) UpsertServeModel(serveModel *openapi.ServeModel, inferenceServiceId *string) (*openapi.ServeModel, error) {
+	id, _ := serveModel.GetIdOk()
+	result, err := i.ModelRegistryApi.UpsertServeModel(serveModel, inferenceServiceId)
+	i.record("ServeModel", id, err)
+	return result, err
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryClientInterceptor records MLMD gRPC request counts and latency per method and status code,
+// it is meant to be installed with grpc.WithChainUnaryInterceptor when dialing MLMD.
+func (m *Metrics) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		code := status.Code(err).String()
+		m.grpcRequests.WithLabelValues(method, code).Inc()
+		m.grpcDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
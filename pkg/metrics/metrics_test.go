@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeApi only implements the operations exercised by the tests
+type fakeApi struct {
+	api.ModelRegistryApi
+}
+
+func (f *fakeApi) UpsertRegisteredModel(registeredModel *openapi.RegisteredModel) (*openapi.RegisteredModel, error) {
+	if registeredModel.GetName() == "" {
+		return nil, fmt.Errorf("missing name: %w", api.ErrBadRequest)
+	}
+	return registeredModel, nil
+}
+
+func setup(t *testing.T) (*assert.Assertions, *Metrics) {
+	m, err := NewMetrics(prometheus.NewRegistry())
+	if err != nil {
+		t.Fatalf("error creating metrics: %v", err)
+	}
+	return assert.New(t), m
+}
+
+func TestInstrumentApiCountsUpserts(t *testing.T) {
+	assertion, m := setup(t)
+	instrumented := m.InstrumentApi(&fakeApi{})
+
+	_, err := instrumented.UpsertRegisteredModel(&openapi.RegisteredModel{Name: apiutils.Of("model")})
+	assertion.Nil(err)
+	_, err = instrumented.UpsertRegisteredModel(&openapi.RegisteredModel{Id: apiutils.Of("1"), Name: apiutils.Of("model")})
+	assertion.Nil(err)
+	_, err = instrumented.UpsertRegisteredModel(&openapi.RegisteredModel{})
+	assertion.NotNil(err)
+
+	assertion.Equal(float64(1), testutil.ToFloat64(m.entityOperations.WithLabelValues("RegisteredModel", operationCreate)))
+	assertion.Equal(float64(1), testutil.ToFloat64(m.entityOperations.WithLabelValues("RegisteredModel", operationUpdate)))
+}
+
+func TestInstrumentApiForTenantNotSupported(t *testing.T) {
+	assertion, m := setup(t)
+	instrumented := m.InstrumentApi(&fakeApi{})
+
+	_, err := instrumented.(api.TenantScopedApi).ForTenant("team-a")
+	assertion.NotNil(err)
+}
+
+func TestMiddlewareUsesRoutePattern(t *testing.T) {
+	assertion, m := setup(t)
+
+	inner := chi.NewRouter()
+	inner.Get("/models/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	router := chi.NewRouter()
+	router.Use(m.Middleware)
+	router.Mount("/", inner)
+
+	for _, path := range []string{"/models/1", "/models/2", "/unknown"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assertion.Equal(float64(2), testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "/models/{id}", "404")))
+	assertion.Equal(float64(1), testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, unmatchedRoute, "404")))
+	assertion.Equal(float64(0), testutil.ToFloat64(m.httpInFlight))
+}
@@ -0,0 +1,157 @@
+// Package modelcard renders model cards from the registry data: a registered model, its versions, their artifacts,
+// metrics and deployments. Cards are rendered as Markdown and HTML with Go templates, which operators can override
+// in whole or section by section, or as JSON.
+package modelcard
+
+import (
+	"strconv"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// pageSize is the size of the pages requested while gathering the card data
+const pageSize int32 = 100
+
+// Build gathers the model card of the registered model identified by registeredModelId, with all its versions
+func Build(service api.ModelRegistryApi, registeredModelId string) (*openapi.ModelCard, error) {
+	registeredModel, err := service.GetRegisteredModelById(registeredModelId)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := listAll(func(listOptions api.ListOptions) ([]openapi.ModelVersion, string, error) {
+		// newest first
+		listOptions.OrderBy = apiutils.Of(string(openapi.ORDERBYFIELD_CREATE_TIME))
+		listOptions.SortOrder = apiutils.Of(string(openapi.SORTORDER_DESC))
+		list, err := service.GetModelVersions(listOptions, &registeredModelId)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return build(service, registeredModel, versions)
+}
+
+// BuildModelVersion gathers the model card of the registered model of the model version identified by
+// modelVersionId, restricted to that version
+func BuildModelVersion(service api.ModelRegistryApi, modelVersionId string) (*openapi.ModelCard, error) {
+	modelVersion, err := service.GetModelVersionById(modelVersionId)
+	if err != nil {
+		return nil, err
+	}
+	registeredModel, err := service.GetRegisteredModelById(modelVersion.RegisteredModelId)
+	if err != nil {
+		return nil, err
+	}
+	return build(service, registeredModel, []openapi.ModelVersion{*modelVersion})
+}
+
+func build(service api.ModelRegistryApi, registeredModel *openapi.RegisteredModel, versions []openapi.ModelVersion) (*openapi.ModelCard, error) {
+	deployments, err := deploymentsByVersion(service, registeredModel.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	card := openapi.NewModelCard(*registeredModel, make([]openapi.ModelCardVersion, 0, len(versions)))
+	for _, version := range versions {
+		entry := openapi.NewModelCardVersion(version)
+		artifacts, err := listAll(func(listOptions api.ListOptions) ([]openapi.Artifact, string, error) {
+			list, err := service.GetArtifacts(listOptions, version.Id)
+			if err != nil {
+				return nil, "", err
+			}
+			return list.Items, list.NextPageToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		metrics := map[string]float64{}
+		for _, artifact := range artifacts {
+			switch {
+			case artifact.ModelArtifact != nil:
+				entry.ModelArtifacts = append(entry.ModelArtifacts, *artifact.ModelArtifact)
+				addMetrics(metrics, artifact.ModelArtifact.GetCustomProperties())
+			case artifact.DocArtifact != nil:
+				entry.DocArtifacts = append(entry.DocArtifacts, *artifact.DocArtifact)
+			}
+		}
+		// the metrics of the version take precedence over the ones of its artifacts
+		addMetrics(metrics, version.GetCustomProperties())
+		if len(metrics) > 0 {
+			entry.SetMetrics(metrics)
+		}
+		entry.Deployments = deployments[version.GetId()]
+
+		card.Versions = append(card.Versions, *entry)
+	}
+	return card, nil
+}
+
+// deploymentsByVersion returns the inference services of the registered model identified by registeredModelId,
+// by the id of the model version they deploy
+func deploymentsByVersion(service api.ModelRegistryApi, registeredModelId string) (map[string][]openapi.InferenceService, error) {
+	inferenceServices, err := listAll(func(listOptions api.ListOptions) ([]openapi.InferenceService, string, error) {
+		list, err := service.GetInferenceServices(listOptions, nil, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := map[string][]openapi.InferenceService{}
+	for _, inferenceService := range inferenceServices {
+		if inferenceService.RegisteredModelId != registeredModelId {
+			continue
+		}
+		versionId := inferenceService.GetModelVersionId()
+		if versionId == "" {
+			// the inference service deploys the latest version of the model
+			version, err := service.GetModelVersionByInferenceService(inferenceService.GetId())
+			if err != nil {
+				return nil, err
+			}
+			versionId = version.GetId()
+		}
+		deployments[versionId] = append(deployments[versionId], inferenceService)
+	}
+	return deployments, nil
+}
+
+// addMetrics adds the numeric custom properties to metrics, replacing existing ones
+func addMetrics(metrics map[string]float64, customProperties map[string]openapi.MetadataValue) {
+	for key, value := range customProperties {
+		switch {
+		case value.MetadataDoubleValue != nil:
+			metrics[key] = value.MetadataDoubleValue.DoubleValue
+		case value.MetadataIntValue != nil:
+			if intValue, err := strconv.ParseInt(value.MetadataIntValue.IntValue, 10, 64); err == nil {
+				metrics[key] = float64(intValue)
+			}
+		}
+	}
+}
+
+// listAll returns the items of all the pages returned by list
+func listAll[T any](list func(listOptions api.ListOptions) ([]T, string, error)) ([]T, error) {
+	var all []T
+	listOptions := api.ListOptions{PageSize: apiutils.Of(pageSize)}
+	for {
+		items, nextPageToken, err := list(listOptions)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if nextPageToken == "" || len(items) == 0 {
+			return all, nil
+		}
+		listOptions.NextPageToken = &nextPageToken
+	}
+}
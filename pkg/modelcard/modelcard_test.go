@@ -0,0 +1,225 @@
+package modelcard
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeApi only implements the read operations gathering the card data, with pages of a single item
+type fakeApi struct {
+	api.ModelRegistryApi
+	registeredModel   openapi.RegisteredModel
+	versions          []openapi.ModelVersion
+	artifacts         map[string][]openapi.Artifact
+	inferenceServices []openapi.InferenceService
+}
+
+// page returns the page of items requested by listOptions, one item per page
+func page[T any](items []T, listOptions api.ListOptions) ([]T, string) {
+	i := 0
+	if listOptions.NextPageToken != nil {
+		i = int((*listOptions.NextPageToken)[0] - '0')
+	}
+	if i >= len(items) {
+		return nil, ""
+	}
+	next := ""
+	if i+1 < len(items) {
+		next = string(rune('0' + i + 1))
+	}
+	return items[i : i+1], next
+}
+
+func (f *fakeApi) GetRegisteredModelById(id string) (*openapi.RegisteredModel, error) {
+	if id != f.registeredModel.GetId() {
+		return nil, api.ErrNotFound
+	}
+	return &f.registeredModel, nil
+}
+
+func (f *fakeApi) GetModelVersionById(id string) (*openapi.ModelVersion, error) {
+	for _, version := range f.versions {
+		if version.GetId() == id {
+			return &version, nil
+		}
+	}
+	return nil, api.ErrNotFound
+}
+
+func (f *fakeApi) GetModelVersionByInferenceService(inferenceServiceId string) (*openapi.ModelVersion, error) {
+	return &f.versions[0], nil
+}
+
+func (f *fakeApi) GetModelVersions(listOptions api.ListOptions, registeredModelId *string) (*openapi.ModelVersionList, error) {
+	items, next := page(f.versions, listOptions)
+	return &openapi.ModelVersionList{Items: items, NextPageToken: next, Size: int32(len(items))}, nil
+}
+
+func (f *fakeApi) GetArtifacts(listOptions api.ListOptions, modelVersionId *string) (*openapi.ArtifactList, error) {
+	items, next := page(f.artifacts[*modelVersionId], listOptions)
+	return &openapi.ArtifactList{Items: items, NextPageToken: next, Size: int32(len(items))}, nil
+}
+
+func (f *fakeApi) GetInferenceServices(listOptions api.ListOptions, servingEnvironmentId *string, runtime *string) (*openapi.InferenceServiceList, error) {
+	items, next := page(f.inferenceServices, listOptions)
+	return &openapi.InferenceServiceList{Items: items, NextPageToken: next, Size: int32(len(items))}, nil
+}
+
+func newFakeApi() *fakeApi {
+	return &fakeApi{
+		registeredModel: openapi.RegisteredModel{
+			Id:          apiutils.Of("1"),
+			Name:        apiutils.Of("fraud-detector"),
+			Description: apiutils.Of("Flags | suspicious transactions"),
+			Owner:       apiutils.Of("risk-team"),
+		},
+		versions: []openapi.ModelVersion{
+			{
+				Id:                apiutils.Of("3"),
+				Name:              apiutils.Of("v2"),
+				RegisteredModelId: "1",
+				Author:            apiutils.Of("alice"),
+				CustomProperties: &map[string]openapi.MetadataValue{
+					"accuracy": openapi.MetadataDoubleValueAsMetadataValue(openapi.NewMetadataDoubleValue(0.97, "MetadataDoubleValue")),
+					"stage":    openapi.MetadataStringValueAsMetadataValue(openapi.NewMetadataStringValue("prod", "MetadataStringValue")),
+				},
+			},
+			{Id: apiutils.Of("2"), Name: apiutils.Of("v1"), RegisteredModelId: "1"},
+		},
+		artifacts: map[string][]openapi.Artifact{
+			"3": {
+				{ModelArtifact: &openapi.ModelArtifact{
+					Id:              apiutils.Of("4"),
+					Name:            apiutils.Of("model"),
+					Uri:             apiutils.Of("s3://models/fraud/v2"),
+					ModelFormatName: apiutils.Of("onnx"),
+					CustomProperties: &map[string]openapi.MetadataValue{
+						"accuracy": openapi.MetadataDoubleValueAsMetadataValue(openapi.NewMetadataDoubleValue(0.95, "MetadataDoubleValue")),
+						"latency":  openapi.MetadataIntValueAsMetadataValue(openapi.NewMetadataIntValue("12", "MetadataIntValue")),
+					},
+				}},
+				{DocArtifact: &openapi.DocArtifact{Id: apiutils.Of("5"), Name: apiutils.Of("README"), Uri: apiutils.Of("https://docs/fraud")}},
+			},
+		},
+		inferenceServices: []openapi.InferenceService{
+			{Id: apiutils.Of("6"), Name: apiutils.Of("fraud-latest"), RegisteredModelId: "1", ServingEnvironmentId: "7"},
+			{Id: apiutils.Of("8"), Name: apiutils.Of("fraud-v1"), RegisteredModelId: "1", ModelVersionId: apiutils.Of("2"), ServingEnvironmentId: "7"},
+			{Id: apiutils.Of("9"), Name: apiutils.Of("other"), RegisteredModelId: "10", ServingEnvironmentId: "7"},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	assertion := assert.New(t)
+
+	card, err := Build(newFakeApi(), "1")
+	assertion.Nil(err)
+	assertion.Equal("fraud-detector", card.RegisteredModel.GetName())
+	assertion.Len(card.Versions, 2)
+
+	latest := card.Versions[0]
+	assertion.Equal("v2", latest.ModelVersion.GetName())
+	assertion.Len(latest.ModelArtifacts, 1)
+	assertion.Len(latest.DocArtifacts, 1)
+	assertion.Equal(map[string]float64{"accuracy": 0.97, "latency": 12}, latest.GetMetrics(), "version metrics take precedence")
+	assertion.Len(latest.Deployments, 1)
+	assertion.Equal("fraud-latest", latest.Deployments[0].GetName(), "deployments without version serve the latest one")
+
+	assertion.Nil(card.Versions[1].Metrics)
+	assertion.Len(card.Versions[1].Deployments, 1)
+	assertion.Equal("fraud-v1", card.Versions[1].Deployments[0].GetName())
+
+	_, err = Build(newFakeApi(), "2")
+	assertion.ErrorIs(err, api.ErrNotFound)
+}
+
+func TestBuildModelVersion(t *testing.T) {
+	assertion := assert.New(t)
+
+	card, err := BuildModelVersion(newFakeApi(), "2")
+	assertion.Nil(err)
+	assertion.Equal("fraud-detector", card.RegisteredModel.GetName())
+	assertion.Len(card.Versions, 1)
+	assertion.Equal("v1", card.Versions[0].ModelVersion.GetName())
+}
+
+func TestRender(t *testing.T) {
+	assertion := assert.New(t)
+	card, err := Build(newFakeApi(), "1")
+	assertion.Nil(err)
+
+	var markdown bytes.Buffer
+	assertion.Nil(DefaultRenderer().Render(&markdown, card, Markdown))
+	assertion.Contains(markdown.String(), "# fraud-detector\n")
+	assertion.Contains(markdown.String(), "| Owner | risk-team |")
+	assertion.Contains(markdown.String(), "### v2\n")
+	assertion.Contains(markdown.String(), "| accuracy | 0.97 |")
+	assertion.Contains(markdown.String(), "| model | onnx | s3://models/fraud/v2 |")
+	assertion.Contains(markdown.String(), "- [README](https://docs/fraud)")
+	assertion.Contains(markdown.String(), "| fraud-latest | 7 |")
+
+	var html bytes.Buffer
+	assertion.Nil(DefaultRenderer().Render(&html, card, HTML))
+	assertion.Contains(html.String(), "<h1>fraud-detector</h1>")
+	assertion.Contains(html.String(), "Flags | suspicious transactions")
+	assertion.Contains(html.String(), `<a href="https://docs/fraud">README</a>`)
+
+	var data bytes.Buffer
+	assertion.Nil(DefaultRenderer().Render(&data, card, JSON))
+	decoded := openapi.ModelCard{}
+	assertion.Nil(json.Unmarshal(data.Bytes(), &decoded))
+	assertion.Equal(*card, decoded)
+}
+
+func TestRenderOverrides(t *testing.T) {
+	assertion := assert.New(t)
+	dir := t.TempDir()
+	assertion.Nil(os.WriteFile(filepath.Join(dir, "governance.md.tmpl"), []byte(`{{define "governance"}}
+## Intended use
+
+Fraud detection on card payments only.
+{{end}}`), 0o600))
+	assertion.Nil(os.WriteFile(filepath.Join(dir, HTMLTemplate), []byte(`<p>{{ .RegisteredModel.GetOwner }}</p>`), 0o600))
+
+	renderer, err := NewRenderer(dir)
+	assertion.Nil(err)
+	card, err := Build(newFakeApi(), "1")
+	assertion.Nil(err)
+
+	var markdown bytes.Buffer
+	assertion.Nil(renderer.Render(&markdown, card, Markdown))
+	assertion.Contains(markdown.String(), "## Intended use\n\nFraud detection on card payments only.\n")
+	assertion.Contains(markdown.String(), "### v2\n", "other sections are kept")
+
+	var html bytes.Buffer
+	assertion.Nil(renderer.Render(&html, card, HTML))
+	assertion.Equal("<p>risk-team</p>", html.String())
+
+	assertion.Nil(os.WriteFile(filepath.Join(dir, "broken.md.tmpl"), []byte(`{{ end }}`), 0o600))
+	_, err = NewRenderer(dir)
+	assertion.NotNil(err)
+}
+
+func TestFormats(t *testing.T) {
+	assertion := assert.New(t)
+
+	format, err := ParseFormat("html")
+	assertion.Nil(err)
+	assertion.Equal(HTML, format)
+	_, err = ParseFormat("pdf")
+	assertion.ErrorIs(err, api.ErrBadRequest)
+
+	assertion.Equal(Markdown, NegotiateFormat(""))
+	assertion.Equal(Markdown, NegotiateFormat("*/*"))
+	assertion.Equal(HTML, NegotiateFormat("text/html,application/xhtml+xml,*/*;q=0.8"))
+	assertion.Equal(JSON, NegotiateFormat("application/json"))
+	assertion.Equal("text/markdown; charset=utf-8", Markdown.ContentType())
+}
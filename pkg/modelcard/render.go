@@ -0,0 +1,185 @@
+package modelcard
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"mime"
+	"path/filepath"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// Format is a model card format
+type Format string
+
+const (
+	Markdown Format = "markdown"
+	HTML     Format = "html"
+	JSON     Format = "json"
+)
+
+// Names of the main templates, overridden by the files with the same name in the templates directory
+const (
+	MarkdownTemplate = "model_card.md.tmpl"
+	HTMLTemplate     = "model_card.html.tmpl"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+var contentTypes = map[Format]string{
+	Markdown: "text/markdown; charset=utf-8",
+	HTML:     "text/html; charset=utf-8",
+	JSON:     "application/json; charset=utf-8",
+}
+
+// ParseFormat returns the format named format
+func ParseFormat(format string) (Format, error) {
+	if _, ok := contentTypes[Format(format)]; !ok {
+		return "", fmt.Errorf("invalid model card format %q, must be one of markdown, html or json: %w", format, api.ErrBadRequest)
+	}
+	return Format(format), nil
+}
+
+// NegotiateFormat returns the first format accepted by the Accept header value accept, Markdown when none is
+func NegotiateFormat(accept string) Format {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "text/markdown":
+			return Markdown
+		case "text/html":
+			return HTML
+		case "application/json":
+			return JSON
+		}
+	}
+	return Markdown
+}
+
+// ContentType returns the HTTP content type of cards in format f
+func (f Format) ContentType() string {
+	return contentTypes[f]
+}
+
+// Renderer renders model cards with its Markdown and HTML templates
+type Renderer struct {
+	markdown *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+// NewRenderer returns a renderer using the default templates, overridden by the templates in templatesDir, if not
+// empty. Files named after the main templates replace them, and the templates defined by all the *.md.tmpl and
+// *.html.tmpl files replace the default sections with the same name, e.g. a governance.md.tmpl file with
+// {{define "governance"}}...{{end}} adds a governance section to Markdown cards.
+func NewRenderer(templatesDir string) (*Renderer, error) {
+	markdown, err := texttemplate.New(MarkdownTemplate).Funcs(texttemplate.FuncMap(funcs)).ParseFS(defaultTemplates, "templates/*.md.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("error parsing default Markdown templates: %w", err)
+	}
+	html, err := htmltemplate.New(HTMLTemplate).Funcs(htmltemplate.FuncMap(funcs)).ParseFS(defaultTemplates, "templates/*.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("error parsing default HTML templates: %w", err)
+	}
+
+	if templatesDir != "" {
+		if files, _ := filepath.Glob(filepath.Join(templatesDir, "*.md.tmpl")); len(files) > 0 {
+			if markdown, err = markdown.ParseFiles(files...); err != nil {
+				return nil, fmt.Errorf("error parsing Markdown templates: %w", err)
+			}
+		}
+		if files, _ := filepath.Glob(filepath.Join(templatesDir, "*.html.tmpl")); len(files) > 0 {
+			if html, err = html.ParseFiles(files...); err != nil {
+				return nil, fmt.Errorf("error parsing HTML templates: %w", err)
+			}
+		}
+	}
+	return &Renderer{markdown: markdown, html: html}, nil
+}
+
+// DefaultRenderer returns a renderer using the default templates only
+func DefaultRenderer() *Renderer {
+	return defaultRenderer
+}
+
+var defaultRenderer = func() *Renderer {
+	renderer, err := NewRenderer("")
+	if err != nil {
+		panic(err)
+	}
+	return renderer
+}()
+
+// Render writes card to w in format
+func (r *Renderer) Render(w io.Writer, card *openapi.ModelCard, format Format) error {
+	switch format {
+	case Markdown:
+		return r.markdown.ExecuteTemplate(w, MarkdownTemplate, card)
+	case HTML:
+		return r.html.ExecuteTemplate(w, HTMLTemplate, card)
+	case JSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(card)
+	}
+	return fmt.Errorf("invalid model card format %q: %w", format, api.ErrBadRequest)
+}
+
+// funcs are the functions available to templates, besides the built-in ones
+var funcs = map[string]any{
+	"value":     metadataValue,
+	"timestamp": timestamp,
+	"cell":      cell,
+}
+
+// metadataValue returns a readable representation of value
+func metadataValue(value openapi.MetadataValue) string {
+	switch {
+	case value.MetadataIntValue != nil:
+		return value.MetadataIntValue.IntValue
+	case value.MetadataDoubleValue != nil:
+		return strconv.FormatFloat(value.MetadataDoubleValue.DoubleValue, 'g', -1, 64)
+	case value.MetadataStringValue != nil:
+		return value.MetadataStringValue.StringValue
+	case value.MetadataBoolValue != nil:
+		return strconv.FormatBool(value.MetadataBoolValue.BoolValue)
+	case value.MetadataListValue != nil:
+		items := make([]string, 0, len(value.MetadataListValue.ListValue))
+		for _, item := range value.MetadataListValue.ListValue {
+			items = append(items, fmt.Sprint(item))
+		}
+		return strings.Join(items, ", ")
+	case value.MetadataStructValue != nil:
+		data, _ := json.Marshal(value.MetadataStructValue.StructValue)
+		return string(data)
+	case value.MetadataProtoValue != nil:
+		return value.MetadataProtoValue.Type
+	}
+	return ""
+}
+
+// timestamp formats a time since epoch in milliseconds, as found in registry entities, returning it as is when
+// not numeric
+func timestamp(sinceEpoch string) string {
+	millis, err := strconv.ParseInt(sinceEpoch, 10, 64)
+	if err != nil {
+		return sinceEpoch
+	}
+	return time.UnixMilli(millis).UTC().Format("2006-01-02 15:04:05 MST")
+}
+
+// cell escapes s to fit in a Markdown table cell
+func cell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ").Replace(s)
+}
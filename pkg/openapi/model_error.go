@@ -19,10 +19,14 @@ var _ MappedNullable = &Error{}
 
 // Error Error code and message.
 type Error struct {
-	// Error code
+	// Error code, one of `BAD_REQUEST`, `NOT_FOUND`, `CONFLICT`, `PRECONDITION_FAILED`, `FORBIDDEN`, `UNAVAILABLE`, `VALIDATION_FAILED` or `INTERNAL`
 	Code string `json:"code"`
 	// Error message
 	Message string `json:"message"`
+	// Violations of individual fields, when validation failed
+	Details []ErrorDetail `json:"details,omitempty"`
+	// Id of the request, as returned in the X-Request-Id response header
+	RequestId *string `json:"requestId,omitempty"`
 }
 
 // NewError instantiates a new Error object
@@ -92,6 +96,70 @@ func (o *Error) SetMessage(v string) {
 	o.Message = v
 }
 
+// GetDetails returns the Details field value if set, zero value otherwise.
+func (o *Error) GetDetails() []ErrorDetail {
+	if o == nil || IsNil(o.Details) {
+		var ret []ErrorDetail
+		return ret
+	}
+	return o.Details
+}
+
+// GetDetailsOk returns a tuple with the Details field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Error) GetDetailsOk() ([]ErrorDetail, bool) {
+	if o == nil || IsNil(o.Details) {
+		return nil, false
+	}
+	return o.Details, true
+}
+
+// HasDetails returns a boolean if a field has been set.
+func (o *Error) HasDetails() bool {
+	if o != nil && !IsNil(o.Details) {
+		return true
+	}
+
+	return false
+}
+
+// SetDetails gets a reference to the given []ErrorDetail and assigns it to the Details field.
+func (o *Error) SetDetails(v []ErrorDetail) {
+	o.Details = v
+}
+
+// GetRequestId returns the RequestId field value if set, zero value otherwise.
+func (o *Error) GetRequestId() string {
+	if o == nil || IsNil(o.RequestId) {
+		var ret string
+		return ret
+	}
+	return *o.RequestId
+}
+
+// GetRequestIdOk returns a tuple with the RequestId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Error) GetRequestIdOk() (*string, bool) {
+	if o == nil || IsNil(o.RequestId) {
+		return nil, false
+	}
+	return o.RequestId, true
+}
+
+// HasRequestId returns a boolean if a field has been set.
+func (o *Error) HasRequestId() bool {
+	if o != nil && !IsNil(o.RequestId) {
+		return true
+	}
+
+	return false
+}
+
+// SetRequestId gets a reference to the given string and assigns it to the RequestId field.
+func (o *Error) SetRequestId(v string) {
+	o.RequestId = &v
+}
+
 func (o Error) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -104,6 +172,12 @@ func (o Error) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["code"] = o.Code
 	toSerialize["message"] = o.Message
+	if !IsNil(o.Details) {
+		toSerialize["details"] = o.Details
+	}
+	if !IsNil(o.RequestId) {
+		toSerialize["requestId"] = o.RequestId
+	}
 	return toSerialize, nil
 }
 
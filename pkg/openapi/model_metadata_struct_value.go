@@ -19,16 +19,16 @@ var _ MappedNullable = &MetadataStructValue{}
 
 // MetadataStructValue A struct property value.
 type MetadataStructValue struct {
-	// Base64 encoded bytes for struct value
-	StructValue  string `json:"struct_value"`
-	MetadataType string `json:"metadataType"`
+	// The struct value, as a JSON object, or as the base64 encoded bytes of its JSON representation. Struct values are always returned base64 encoded.
+	StructValue  interface{} `json:"struct_value"`
+	MetadataType string      `json:"metadataType"`
 }
 
 // NewMetadataStructValue instantiates a new MetadataStructValue object
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewMetadataStructValue(structValue string, metadataType string) *MetadataStructValue {
+func NewMetadataStructValue(structValue interface{}, metadataType string) *MetadataStructValue {
 	this := MetadataStructValue{}
 	this.StructValue = structValue
 	this.MetadataType = metadataType
@@ -46,9 +46,10 @@ func NewMetadataStructValueWithDefaults() *MetadataStructValue {
 }
 
 // GetStructValue returns the StructValue field value
-func (o *MetadataStructValue) GetStructValue() string {
+// If the value is explicit nil, the zero value for interface{} will be returned
+func (o *MetadataStructValue) GetStructValue() interface{} {
 	if o == nil {
-		var ret string
+		var ret interface{}
 		return ret
 	}
 
@@ -57,15 +58,16 @@ func (o *MetadataStructValue) GetStructValue() string {
 
 // GetStructValueOk returns a tuple with the StructValue field value
 // and a boolean to check if the value has been set.
-func (o *MetadataStructValue) GetStructValueOk() (*string, bool) {
-	if o == nil {
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *MetadataStructValue) GetStructValueOk() (*interface{}, bool) {
+	if o == nil || IsNil(o.StructValue) {
 		return nil, false
 	}
 	return &o.StructValue, true
 }
 
 // SetStructValue sets field value
-func (o *MetadataStructValue) SetStructValue(v string) {
+func (o *MetadataStructValue) SetStructValue(v interface{}) {
 	o.StructValue = v
 }
 
@@ -103,7 +105,9 @@ func (o MetadataStructValue) MarshalJSON() ([]byte, error) {
 
 func (o MetadataStructValue) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
-	toSerialize["struct_value"] = o.StructValue
+	if o.StructValue != nil {
+		toSerialize["struct_value"] = o.StructValue
+	}
 	toSerialize["metadataType"] = o.MetadataType
 	return toSerialize, nil
 }
@@ -20,6 +20,7 @@ type MetadataValue struct {
 	MetadataBoolValue   *MetadataBoolValue
 	MetadataDoubleValue *MetadataDoubleValue
 	MetadataIntValue    *MetadataIntValue
+	MetadataListValue   *MetadataListValue
 	MetadataProtoValue  *MetadataProtoValue
 	MetadataStringValue *MetadataStringValue
 	MetadataStructValue *MetadataStructValue
@@ -46,6 +47,13 @@ func MetadataIntValueAsMetadataValue(v *MetadataIntValue) MetadataValue {
 	}
 }
 
+// MetadataListValueAsMetadataValue is a convenience function that returns MetadataListValue wrapped in MetadataValue
+func MetadataListValueAsMetadataValue(v *MetadataListValue) MetadataValue {
+	return MetadataValue{
+		MetadataListValue: v,
+	}
+}
+
 // MetadataProtoValueAsMetadataValue is a convenience function that returns MetadataProtoValue wrapped in MetadataValue
 func MetadataProtoValueAsMetadataValue(v *MetadataProtoValue) MetadataValue {
 	return MetadataValue{
@@ -113,6 +121,18 @@ func (dst *MetadataValue) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	// check if the discriminator value is 'MetadataListValue'
+	if jsonDict["metadataType"] == "MetadataListValue" {
+		// try to unmarshal JSON data into MetadataListValue
+		err = json.Unmarshal(data, &dst.MetadataListValue)
+		if err == nil {
+			return nil // data stored in dst.MetadataListValue, return on the first match
+		} else {
+			dst.MetadataListValue = nil
+			return fmt.Errorf("failed to unmarshal MetadataValue as MetadataListValue: %s", err.Error())
+		}
+	}
+
 	// check if the discriminator value is 'MetadataProtoValue'
 	if jsonDict["metadataType"] == "MetadataProtoValue" {
 		// try to unmarshal JSON data into MetadataProtoValue
@@ -166,6 +186,10 @@ func (src MetadataValue) MarshalJSON() ([]byte, error) {
 		return json.Marshal(&src.MetadataIntValue)
 	}
 
+	if src.MetadataListValue != nil {
+		return json.Marshal(&src.MetadataListValue)
+	}
+
 	if src.MetadataProtoValue != nil {
 		return json.Marshal(&src.MetadataProtoValue)
 	}
@@ -198,6 +222,10 @@ func (obj *MetadataValue) GetActualInstance() interface{} {
 		return obj.MetadataIntValue
 	}
 
+	if obj.MetadataListValue != nil {
+		return obj.MetadataListValue
+	}
+
 	if obj.MetadataProtoValue != nil {
 		return obj.MetadataProtoValue
 	}
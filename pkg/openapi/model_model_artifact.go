@@ -46,7 +46,10 @@ type ModelArtifact struct {
 	// Version of the model format.
 	ModelFormatVersion *string `json:"modelFormatVersion,omitempty"`
 	// Name of the service account with storage secret.
-	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+	ServiceAccountName *string         `json:"serviceAccountName,omitempty"`
+	Signature          *ModelSignature `json:"signature,omitempty"`
+	// Digests of the content of the artifact: a single one for the file at `uri`, or one per file, i.e. a manifest, when `uri` points to a directory. Once set, they can only be changed or removed by updates explicitly allowing it.
+	Digests []ArtifactDigest `json:"digests,omitempty"`
 }
 
 // NewModelArtifact instantiates a new ModelArtifact object
@@ -544,6 +547,70 @@ func (o *ModelArtifact) SetServiceAccountName(v string) {
 	o.ServiceAccountName = &v
 }
 
+// GetSignature returns the Signature field value if set, zero value otherwise.
+func (o *ModelArtifact) GetSignature() ModelSignature {
+	if o == nil || IsNil(o.Signature) {
+		var ret ModelSignature
+		return ret
+	}
+	return *o.Signature
+}
+
+// GetSignatureOk returns a tuple with the Signature field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ModelArtifact) GetSignatureOk() (*ModelSignature, bool) {
+	if o == nil || IsNil(o.Signature) {
+		return nil, false
+	}
+	return o.Signature, true
+}
+
+// HasSignature returns a boolean if a field has been set.
+func (o *ModelArtifact) HasSignature() bool {
+	if o != nil && !IsNil(o.Signature) {
+		return true
+	}
+
+	return false
+}
+
+// SetSignature gets a reference to the given ModelSignature and assigns it to the Signature field.
+func (o *ModelArtifact) SetSignature(v ModelSignature) {
+	o.Signature = &v
+}
+
+// GetDigests returns the Digests field value if set, zero value otherwise.
+func (o *ModelArtifact) GetDigests() []ArtifactDigest {
+	if o == nil || IsNil(o.Digests) {
+		var ret []ArtifactDigest
+		return ret
+	}
+	return o.Digests
+}
+
+// GetDigestsOk returns a tuple with the Digests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ModelArtifact) GetDigestsOk() ([]ArtifactDigest, bool) {
+	if o == nil || IsNil(o.Digests) {
+		return nil, false
+	}
+	return o.Digests, true
+}
+
+// HasDigests returns a boolean if a field has been set.
+func (o *ModelArtifact) HasDigests() bool {
+	if o != nil && !IsNil(o.Digests) {
+		return true
+	}
+
+	return false
+}
+
+// SetDigests gets a reference to the given []ArtifactDigest and assigns it to the Digests field.
+func (o *ModelArtifact) SetDigests(v []ArtifactDigest) {
+	o.Digests = v
+}
+
 func (o ModelArtifact) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -597,6 +664,12 @@ func (o ModelArtifact) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ServiceAccountName) {
 		toSerialize["serviceAccountName"] = o.ServiceAccountName
 	}
+	if !IsNil(o.Signature) {
+		toSerialize["signature"] = o.Signature
+	}
+	if !IsNil(o.Digests) {
+		toSerialize["digests"] = o.Digests
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,142 @@
+// Package signature checks whether the signature of a model, i.e. the tensors or columns it takes as inputs and
+// returns as outputs, is backward compatible with the one of a previous version, so that serving teams find schema
+// mismatches before deploying the new version.
+package signature
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// pageSize is the size of the pages requested while looking for the model artifact of a version
+const pageSize int32 = 100
+
+// Check returns the changes from base to signature breaking clients of base, none when signature is backward
+// compatible: all inputs of base must be kept as optional as they were, with the same data type and accepting the
+// same shapes, new inputs must be optional, and all outputs of base must be kept with the same data type and shapes
+// fitting the base ones.
+func Check(base openapi.ModelSignature, signature openapi.ModelSignature) []string {
+	issues := []string{}
+
+	inputs := byName(signature.Inputs)
+	for _, baseInput := range base.Inputs {
+		input, ok := inputs[baseInput.Name]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("input %s was removed", baseInput.Name))
+			continue
+		}
+		if input.Dtype != baseInput.Dtype {
+			issues = append(issues, fmt.Sprintf("input %s data type changed from %s to %s", input.Name, baseInput.Dtype, input.Dtype))
+		}
+		if !fits(baseInput.Shape, input.Shape) {
+			issues = append(issues, fmt.Sprintf("input %s shape changed from %v to %v, which does not accept all the previous inputs", input.Name, baseInput.Shape, input.Shape))
+		}
+		if baseInput.GetOptional() && !input.GetOptional() {
+			issues = append(issues, fmt.Sprintf("input %s is now required", input.Name))
+		}
+	}
+	baseInputs := byName(base.Inputs)
+	for _, input := range signature.Inputs {
+		if _, ok := baseInputs[input.Name]; !ok && !input.GetOptional() {
+			issues = append(issues, fmt.Sprintf("new input %s is required", input.Name))
+		}
+	}
+
+	outputs := byName(signature.Outputs)
+	for _, baseOutput := range base.Outputs {
+		output, ok := outputs[baseOutput.Name]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("output %s was removed", baseOutput.Name))
+			continue
+		}
+		if output.Dtype != baseOutput.Dtype {
+			issues = append(issues, fmt.Sprintf("output %s data type changed from %s to %s", output.Name, baseOutput.Dtype, output.Dtype))
+		}
+		if !fits(output.Shape, baseOutput.Shape) {
+			issues = append(issues, fmt.Sprintf("output %s shape changed from %v to %v, which does not fit the previous outputs", output.Name, baseOutput.Shape, output.Shape))
+		}
+	}
+	return issues
+}
+
+// CheckModelVersions checks whether the signature of the model version identified by modelVersionId is backward
+// compatible with the one of the model version identified by baseVersionId
+func CheckModelVersions(service api.ModelRegistryApi, modelVersionId string, baseVersionId string) (*openapi.SignatureCompatibility, error) {
+	base, err := ForModelVersion(service, baseVersionId)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := ForModelVersion(service, modelVersionId)
+	if err != nil {
+		return nil, err
+	}
+	issues := Check(*base, *signature)
+	result := openapi.NewSignatureCompatibility(len(issues) == 0, baseVersionId)
+	result.Issues = issues
+	return result, nil
+}
+
+// ForModelVersion returns the signature of the model artifact of the model version identified by modelVersionId,
+// failing with api.ErrBadRequest when the version has none or more than one model artifact with a signature
+func ForModelVersion(service api.ModelRegistryApi, modelVersionId string) (*openapi.ModelSignature, error) {
+	if _, err := service.GetModelVersionById(modelVersionId); err != nil {
+		return nil, err
+	}
+
+	var signature *openapi.ModelSignature
+	listOptions := api.ListOptions{PageSize: apiutils.Of(pageSize)}
+	for {
+		artifacts, err := service.GetArtifacts(listOptions, &modelVersionId)
+		if err != nil {
+			return nil, err
+		}
+		for _, artifact := range artifacts.Items {
+			if artifact.ModelArtifact == nil || artifact.ModelArtifact.Signature == nil {
+				continue
+			}
+			if signature != nil {
+				return nil, fmt.Errorf("model version %s has more than one model artifact with a signature: %w", modelVersionId, api.ErrBadRequest)
+			}
+			signature = artifact.ModelArtifact.Signature
+		}
+		if artifacts.NextPageToken == "" || len(artifacts.Items) == 0 {
+			break
+		}
+		listOptions.NextPageToken = &artifacts.NextPageToken
+	}
+
+	if signature == nil {
+		return nil, fmt.Errorf("model version %s has no model artifact with a signature: %w", modelVersionId, api.ErrBadRequest)
+	}
+	return signature, nil
+}
+
+// byName indexes tensors by name
+func byName(tensors []openapi.TensorSpec) map[string]openapi.TensorSpec {
+	result := make(map[string]openapi.TensorSpec, len(tensors))
+	for _, tensor := range tensors {
+		result[tensor.Name] = tensor
+	}
+	return result
+}
+
+// fits returns whether tensors of shape are accepted where tensors of shape accepting are expected, i.e. whether both
+// have the same rank and every dimension of accepting is either variable (-1) or equal to the one of shape. Unknown
+// (empty) shapes fit any shape.
+func fits(shape []int64, accepting []int64) bool {
+	if len(shape) == 0 || len(accepting) == 0 {
+		return true
+	}
+	if len(shape) != len(accepting) {
+		return false
+	}
+	for i, dimension := range accepting {
+		if dimension != -1 && dimension != shape[i] {
+			return false
+		}
+	}
+	return true
+}
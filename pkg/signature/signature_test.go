@@ -0,0 +1,157 @@
+package signature
+
+import (
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func tensor(name string, dtype string, shape ...int64) openapi.TensorSpec {
+	return openapi.TensorSpec{Name: name, Dtype: dtype, Shape: shape}
+}
+
+func optional(spec openapi.TensorSpec) openapi.TensorSpec {
+	spec.Optional = apiutils.Of(true)
+	return spec
+}
+
+var base = openapi.ModelSignature{
+	Inputs: []openapi.TensorSpec{
+		tensor("image", "float32", -1, 224, 224, 3),
+		optional(tensor("threshold", "float32")),
+	},
+	Outputs: []openapi.TensorSpec{
+		tensor("scores", "float32", -1, 10),
+	},
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name      string
+		signature openapi.ModelSignature
+		issues    []string
+	}{
+		{
+			name:      "same signature",
+			signature: base,
+			issues:    []string{},
+		},
+		{
+			name: "compatible changes",
+			signature: openapi.ModelSignature{
+				Inputs: []openapi.TensorSpec{
+					tensor("image", "float32", -1, -1, -1, 3),
+					optional(tensor("threshold", "float32")),
+					optional(tensor("locale", "string")),
+				},
+				Outputs: []openapi.TensorSpec{
+					tensor("scores", "float32", -1, 10),
+					tensor("embedding", "float32", -1, 512),
+				},
+			},
+			issues: []string{},
+		},
+		{
+			name: "unknown shapes",
+			signature: openapi.ModelSignature{
+				Inputs:  []openapi.TensorSpec{tensor("image", "float32"), optional(tensor("threshold", "float32"))},
+				Outputs: []openapi.TensorSpec{tensor("scores", "float32")},
+			},
+			issues: []string{},
+		},
+		{
+			name: "breaking changes",
+			signature: openapi.ModelSignature{
+				Inputs: []openapi.TensorSpec{
+					tensor("image", "uint8", -1, 299, 299, 3),
+					tensor("mask", "bool", -1, 299, 299),
+				},
+				Outputs: []openapi.TensorSpec{
+					tensor("logits", "float32", -1, 10),
+				},
+			},
+			issues: []string{
+				"input image data type changed from float32 to uint8",
+				"input image shape changed from [-1 224 224 3] to [-1 299 299 3], which does not accept all the previous inputs",
+				"input threshold was removed",
+				"new input mask is required",
+				"output scores was removed",
+			},
+		},
+		{
+			name: "stricter changes",
+			signature: openapi.ModelSignature{
+				Inputs: []openapi.TensorSpec{
+					tensor("image", "float32", -1, 224, 224),
+					tensor("threshold", "float32"),
+				},
+				Outputs: []openapi.TensorSpec{
+					tensor("scores", "float64", -1, -1),
+				},
+			},
+			issues: []string{
+				"input image shape changed from [-1 224 224 3] to [-1 224 224], which does not accept all the previous inputs",
+				"input threshold is now required",
+				"output scores data type changed from float32 to float64",
+				"output scores shape changed from [-1 10] to [-1 -1], which does not fit the previous outputs",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.issues, Check(base, tc.signature))
+		})
+	}
+}
+
+// fakeApi only implements the read operations looking for the model artifacts of versions
+type fakeApi struct {
+	api.ModelRegistryApi
+	artifacts map[string][]openapi.Artifact
+}
+
+func (f *fakeApi) GetModelVersionById(id string) (*openapi.ModelVersion, error) {
+	if _, ok := f.artifacts[id]; !ok {
+		return nil, api.ErrNotFound
+	}
+	return &openapi.ModelVersion{Id: &id}, nil
+}
+
+func (f *fakeApi) GetArtifacts(listOptions api.ListOptions, modelVersionId *string) (*openapi.ArtifactList, error) {
+	items := f.artifacts[*modelVersionId]
+	return &openapi.ArtifactList{Items: items, Size: int32(len(items))}, nil
+}
+
+func modelArtifact(signature *openapi.ModelSignature) openapi.Artifact {
+	return openapi.Artifact{ModelArtifact: &openapi.ModelArtifact{Signature: signature}}
+}
+
+func TestCheckModelVersions(t *testing.T) {
+	assertion := assert.New(t)
+	removed := openapi.ModelSignature{Inputs: base.Inputs}
+	service := &fakeApi{artifacts: map[string][]openapi.Artifact{
+		"1": {{DocArtifact: &openapi.DocArtifact{}}, modelArtifact(&base)},
+		"2": {modelArtifact(nil), modelArtifact(&removed)},
+		"3": {modelArtifact(nil)},
+		"4": {modelArtifact(&base), modelArtifact(&base)},
+	}}
+
+	result, err := CheckModelVersions(service, "1", "1")
+	assertion.Nil(err)
+	assertion.Equal(openapi.SignatureCompatibility{Compatible: true, BaseVersionId: "1", Issues: []string{}}, *result)
+
+	result, err = CheckModelVersions(service, "2", "1")
+	assertion.Nil(err)
+	assertion.False(result.Compatible)
+	assertion.Equal([]string{"output scores was removed"}, result.Issues)
+
+	_, err = CheckModelVersions(service, "3", "1")
+	assertion.ErrorIs(err, api.ErrBadRequest)
+	_, err = CheckModelVersions(service, "4", "1")
+	assertion.ErrorIs(err, api.ErrBadRequest)
+	_, err = CheckModelVersions(service, "1", "5")
+	assertion.ErrorIs(err, api.ErrNotFound)
+}
@@ -0,0 +1,251 @@
+// Package signing signs and verifies model artifacts with detached signatures over their digest manifest, made with
+// offline ed25519 or ECDSA keys, so that consumers can check who vouched for the registered content before using it.
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// Signature algorithms, by key type and, for ECDSA, curve and hash
+const (
+	Ed25519         = "ed25519"
+	ECDSAP256SHA256 = "ecdsa-p256-sha256"
+	ECDSAP384SHA384 = "ecdsa-p384-sha384"
+	ECDSAP521SHA512 = "ecdsa-p521-sha512"
+)
+
+// ErrInvalidSignature is returned when a signature doesn't match the manifest and key it is verified with
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Manifest returns the digest manifest of an artifact, i.e. the signed content: the compact JSON encoding of its
+// digests sorted by path and algorithm, with keys in alphabetical order and lowercase values. It fails when there are no digests, as there is nothing
+// to vouch for.
+func Manifest(digests []openapi.ArtifactDigest) ([]byte, error) {
+	if len(digests) == 0 {
+		return nil, fmt.Errorf("no digests to sign")
+	}
+	sorted := make([]openapi.ArtifactDigest, len(digests))
+	for i, digest := range digests {
+		sorted[i] = openapi.ArtifactDigest{Path: digest.Path, Algorithm: digest.Algorithm, Value: strings.ToLower(digest.Value)}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].GetPath() != sorted[j].GetPath() {
+			return sorted[i].GetPath() < sorted[j].GetPath()
+		}
+		return sorted[i].Algorithm < sorted[j].Algorithm
+	})
+	return json.Marshal(sorted)
+}
+
+// ManifestDigest returns the hex encoded SHA-256 of manifest, recorded with signatures to tell which manifest they sign
+func ManifestDigest(manifest []byte) string {
+	sum := sha256.Sum256(manifest)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParsePublicKey parses a PEM encoded PKIX ed25519 or ECDSA public key
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("public key must be PEM encoded with type PUBLIC KEY")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	if _, err := Algorithm(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// KeyID returns the identifier of key: the hex encoded SHA-256 of its PKIX encoding
+func KeyID(key crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", fmt.Errorf("invalid public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Algorithm returns the signature algorithm of key, failing for keys that are neither ed25519 nor ECDSA on the
+// P-256, P-384 or P-521 curves
+func Algorithm(key crypto.PublicKey) (string, error) {
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		return Ed25519, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return ECDSAP256SHA256, nil
+		case elliptic.P384():
+			return ECDSAP384SHA384, nil
+		case elliptic.P521():
+			return ECDSAP521SHA512, nil
+		}
+		return "", fmt.Errorf("unsupported ECDSA curve %s, must be P-256, P-384 or P-521", key.Curve.Params().Name)
+	}
+	return "", fmt.Errorf("unsupported public key type %T, must be ed25519 or ECDSA", key)
+}
+
+// hashFor returns the hash applied to the manifest before signing it with algorithm, none for ed25519
+func hashFor(algorithm string) crypto.Hash {
+	switch algorithm {
+	case ECDSAP256SHA256:
+		return crypto.SHA256
+	case ECDSAP384SHA384:
+		return crypto.SHA384
+	case ECDSAP521SHA512:
+		return crypto.SHA512
+	}
+	return crypto.Hash(0)
+}
+
+// digest returns what is actually signed for manifest with algorithm
+func digest(algorithm string, manifest []byte) []byte {
+	h := hashFor(algorithm)
+	if h == crypto.Hash(0) {
+		return manifest
+	}
+	hasher := h.New()
+	hasher.Write(manifest)
+	return hasher.Sum(nil)
+}
+
+// Sign signs manifest with an ed25519 or ECDSA private key, ECDSA signatures being ASN.1 encoded
+func Sign(key crypto.Signer, manifest []byte) ([]byte, error) {
+	algorithm, err := Algorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return key.Sign(rand.Reader, digest(algorithm, manifest), hashFor(algorithm))
+}
+
+// Verify checks that signature is a signature of manifest made with the private key of key
+func Verify(key crypto.PublicKey, manifest []byte, signature []byte) error {
+	algorithm, err := Algorithm(key)
+	if err != nil {
+		return err
+	}
+	valid := false
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, manifest, signature)
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest(algorithm, manifest), signature)
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// TrustBundle holds the public keys trusted to vouch for model artifacts, by key ID
+type TrustBundle map[string]crypto.PublicKey
+
+// LoadTrustBundle reads the PEM encoded public keys of the file at path or, when path is a directory, of all the
+// files in it. A file can hold several keys.
+func LoadTrustBundle(path string) (TrustBundle, error) {
+	files := []string{path}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading trust bundle: %w", err)
+	}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading trust bundle: %w", err)
+		}
+		files = files[:0]
+		for _, entry := range entries {
+			// skip hidden entries, e.g. the ..data links of mounted config maps and secrets
+			if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	bundle := TrustBundle{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading trust bundle: %w", err)
+		}
+		for {
+			var block *pem.Block
+			block, data = pem.Decode(data)
+			if block == nil {
+				break
+			}
+			key, err := ParsePublicKey(pem.EncodeToMemory(block))
+			if err != nil {
+				return nil, fmt.Errorf("invalid key in trust bundle file %s: %w", file, err)
+			}
+			keyId, err := KeyID(key)
+			if err != nil {
+				return nil, err
+			}
+			bundle[keyId] = key
+		}
+	}
+	if len(bundle) == 0 {
+		return nil, fmt.Errorf("no public keys found in trust bundle %s", path)
+	}
+	return bundle, nil
+}
+
+// VerifyArtifact returns the first signature of an artifact with the provided digests made by a trusted key over
+// its current manifest, failing when there is none.
+func (b TrustBundle) VerifyArtifact(digests []openapi.ArtifactDigest, signatures []openapi.ArtifactSignature) (*openapi.ArtifactSignature, error) {
+	manifest, err := Manifest(digests)
+	if err != nil {
+		return nil, fmt.Errorf("artifact can't be verified: %w", err)
+	}
+	for i, signature := range signatures {
+		key, ok := b[signature.KeyId]
+		if !ok {
+			continue
+		}
+		if err := VerifyEncoded(key, manifest, signature.Signature); err == nil {
+			return &signatures[i], nil
+		}
+	}
+	return nil, fmt.Errorf("artifact is not signed by any trusted key over its current digests")
+}
+
+// VerifyEncoded checks a base64 encoded signature of manifest, see Verify
+func VerifyEncoded(key crypto.PublicKey, manifest []byte, signature string) error {
+	decoded, err := decodeSignature(signature)
+	if err != nil {
+		return err
+	}
+	return Verify(key, manifest, decoded)
+}
+
+// decodeSignature decodes a standard base64 encoded signature
+func decodeSignature(signature string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("signature must be base64 encoded: %w", err)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,136 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var digests = []openapi.ArtifactDigest{
+	{Path: apiutils.Of("weights.bin"), Algorithm: "sha256", Value: "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08"},
+	{Path: apiutils.Of("config.json"), Algorithm: "sha256", Value: "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"},
+}
+
+func publicKeyPEM(t *testing.T, key crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func signers(t *testing.T) map[string]crypto.Signer {
+	_, ed, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	result := map[string]crypto.Signer{Ed25519: ed}
+	for algorithm, curve := range map[string]elliptic.Curve{ECDSAP256SHA256: elliptic.P256(), ECDSAP384SHA384: elliptic.P384(), ECDSAP521SHA512: elliptic.P521()} {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		require.NoError(t, err)
+		result[algorithm] = key
+	}
+	return result
+}
+
+func TestManifest(t *testing.T) {
+	manifest, err := Manifest(digests)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"algorithm":"sha256","path":"config.json","value":"60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"},{"algorithm":"sha256","path":"weights.bin","value":"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"}]`, string(manifest))
+
+	reordered, err := Manifest([]openapi.ArtifactDigest{digests[1], digests[0]})
+	require.NoError(t, err)
+	assert.Equal(t, manifest, reordered)
+	assert.Len(t, ManifestDigest(manifest), 64)
+
+	_, err = Manifest(nil)
+	assert.Error(t, err)
+}
+
+func TestSignVerify(t *testing.T) {
+	manifest, err := Manifest(digests)
+	require.NoError(t, err)
+	tampered, err := Manifest(digests[:1])
+	require.NoError(t, err)
+
+	for algorithm, signer := range signers(t) {
+		t.Run(algorithm, func(t *testing.T) {
+			key, err := ParsePublicKey(publicKeyPEM(t, signer.Public()))
+			require.NoError(t, err)
+			actual, err := Algorithm(key)
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, actual)
+
+			signature, err := Sign(signer, manifest)
+			require.NoError(t, err)
+			assert.NoError(t, Verify(key, manifest, signature))
+			assert.NoError(t, VerifyEncoded(key, manifest, base64.StdEncoding.EncodeToString(signature)))
+			assert.ErrorIs(t, Verify(key, tampered, signature), ErrInvalidSignature)
+			assert.Error(t, VerifyEncoded(key, manifest, "not base64!"))
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = ParsePublicKey(publicKeyPEM(t, rsaKey.Public()))
+	assert.ErrorContains(t, err, "must be ed25519 or ECDSA")
+
+	_, err = ParsePublicKey([]byte("not a key"))
+	assert.ErrorContains(t, err, "PEM encoded")
+}
+
+func TestTrustBundle(t *testing.T) {
+	keys := signers(t)
+	dir := t.TempDir()
+	bundle := append(publicKeyPEM(t, keys[Ed25519].Public()), publicKeyPEM(t, keys[ECDSAP256SHA256].Public())...)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "team.pem"), bundle, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "release.pem"), publicKeyPEM(t, keys[ECDSAP384SHA384].Public()), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("ignored"), 0o600))
+
+	trusted, err := LoadTrustBundle(dir)
+	require.NoError(t, err)
+	assert.Len(t, trusted, 3)
+	single, err := LoadTrustBundle(filepath.Join(dir, "team.pem"))
+	require.NoError(t, err)
+	assert.Len(t, single, 2)
+	_, err = LoadTrustBundle(filepath.Join(dir, ".hidden"))
+	assert.ErrorContains(t, err, "no public keys found")
+
+	manifest, err := Manifest(digests)
+	require.NoError(t, err)
+	signature := func(algorithm string) openapi.ArtifactSignature {
+		signer := keys[algorithm]
+		keyId, err := KeyID(signer.Public())
+		require.NoError(t, err)
+		signed, err := Sign(signer, manifest)
+		require.NoError(t, err)
+		return openapi.ArtifactSignature{KeyId: keyId, Algorithm: algorithm, Signature: base64.StdEncoding.EncodeToString(signed)}
+	}
+	untrusted := signature(ECDSAP521SHA512)
+	invalid := signature(Ed25519)
+	invalid.Signature = signature(ECDSAP256SHA256).Signature
+	valid := signature(ECDSAP384SHA384)
+
+	verified, err := trusted.VerifyArtifact(digests, []openapi.ArtifactSignature{untrusted, invalid, valid})
+	require.NoError(t, err)
+	assert.Equal(t, valid, *verified)
+
+	_, err = trusted.VerifyArtifact(digests, []openapi.ArtifactSignature{untrusted, invalid})
+	assert.ErrorContains(t, err, "not signed by any trusted key")
+	_, err = trusted.VerifyArtifact(digests[:1], []openapi.ArtifactSignature{valid})
+	assert.ErrorContains(t, err, "not signed by any trusted key")
+	_, err = trusted.VerifyArtifact(nil, []openapi.ArtifactSignature{valid})
+	assert.ErrorContains(t, err, "can't be verified")
+}
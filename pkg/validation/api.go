@@ -0,0 +1,145 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+// validatingApi decorates a ModelRegistryApi validating the entities upserted through it,
+// read operations are forwarded as they are to the embedded implementation.
+type validatingApi struct {
+	api.ModelRegistryApi
+	validator *Validator
+}
+
+// ValidatingApi returns a ModelRegistryApi rejecting, with an api.ValidationError, the entities not passing the
+// validator checks, before they reach the provided one. The returned api keeps supporting multi-tenancy if the
+// decorated one does.
+func (v *Validator) ValidatingApi(delegate api.ModelRegistryApi) api.ModelRegistryApi {
+	return &validatingApi{
+		ModelRegistryApi: delegate,
+		validator:        v,
+	}
+}
+
+// ForTenant forwards to the decorated api, validating through the tenant scoped one as well
+func (a *validatingApi) ForTenant(tenant string) (api.ModelRegistryApi, error) {
+	scoped, ok := a.ModelRegistryApi.(api.TenantScopedApi)
+	if !ok {
+		return nil, fmt.Errorf("multi-tenancy is not supported by the decorated api")
+	}
+	delegate, err := scoped.ForTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	return a.validator.ValidatingApi(delegate), nil
+}
+
+// WithContext forwards to the decorated api, the returned api validates entities as well
+func (a *validatingApi) WithContext(ctx context.Context) api.ModelRegistryApi {
+	contextual, ok := a.ModelRegistryApi.(api.ContextualApi)
+	if !ok {
+		return a
+	}
+	return a.validator.ValidatingApi(contextual.WithContext(ctx))
+}
+
+// validate checks the entity being upserted, getExisting is only invoked when updating, i.e. when id is set.
+// Errors getting the existing entity are left to the decorated api to report.
+func validate[T any](v *Validator, entityType string, entity *T, id *string, getExisting func(id string) (*T, error)) error {
+	if entity == nil {
+		return nil
+	}
+	var existing *T
+	if id != nil {
+		existing, _ = getExisting(*id)
+	}
+	var e *Entity
+	var err error
+	if existing != nil {
+		e, err = NewEntity(entityType, entity, existing)
+	} else {
+		e, err = NewEntity(entityType, entity, nil)
+	}
+	if err != nil {
+		return err
+	}
+	return v.Validate(e)
+}
+
+func (a *validatingApi) UpsertRegisteredModel(registeredModel *openapi.RegisteredModel) (*openapi.RegisteredModel, error) {
+	id, _ := registeredModel.GetIdOk()
+	if err := validate(a.validator, RegisteredModel, registeredModel, id, a.GetRegisteredModelById); err != nil {
+		return nil, err
+	}
+	return a.ModelRegistryApi.UpsertRegisteredModel(registeredModel)
+}
+
+func (a *validatingApi) UpsertModelVersion(modelVersion *openapi.ModelVersion, registeredModelId *string) (*openapi.ModelVersion, error) {
+	id, _ := modelVersion.GetIdOk()
+	if err := validate(a.validator, ModelVersion, modelVersion, id, a.GetModelVersionById); err != nil {
+		return nil, err
+	}
+	return a.ModelRegistryApi.UpsertModelVersion(modelVersion, registeredModelId)
+}
+
+func (a *validatingApi) UpsertArtifact(artifact *openapi.Artifact, modelVersionId *string) (*openapi.Artifact, error) {
+	if artifact != nil {
+		var err error
+		if ma := artifact.ModelArtifact; ma != nil {
+			id, _ := ma.GetIdOk()
+			err = validate(a.validator, ModelArtifact, ma, id, a.GetModelArtifactById)
+		} else if da := artifact.DocArtifact; da != nil {
+			id, _ := da.GetIdOk()
+			err = validate(a.validator, DocArtifact, da, id, a.getDocArtifactById)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return a.ModelRegistryApi.UpsertArtifact(artifact, modelVersionId)
+}
+
+func (a *validatingApi) UpsertModelArtifact(modelArtifact *openapi.ModelArtifact, modelVersionId *string) (*openapi.ModelArtifact, error) {
+	id, _ := modelArtifact.GetIdOk()
+	if err := validate(a.validator, ModelArtifact, modelArtifact, id, a.GetModelArtifactById); err != nil {
+		return nil, err
+	}
+	return a.ModelRegistryApi.UpsertModelArtifact(modelArtifact, modelVersionId)
+}
+
+func (a *validatingApi) UpsertServingEnvironment(servingEnvironment *openapi.ServingEnvironment) (*openapi.ServingEnvironment, error) {
+	id, _ := servingEnvironment.GetIdOk()
+	if err := validate(a.validator, ServingEnvironment, servingEnvironment, id, a.GetServingEnvironmentById); err != nil {
+		return nil, err
+	}
+	return a.ModelRegistryApi.UpsertServingEnvironment(servingEnvironment)
+}
+
+func (a *validatingApi) UpsertInferenceService(inferenceService *openapi.InferenceService) (*openapi.InferenceService, error) {
+	id, _ := inferenceService.GetIdOk()
+	if err := validate(a.validator, InferenceService, inferenceService, id, a.GetInferenceServiceById); err != nil {
+		return nil, err
+	}
+	return a.ModelRegistryApi.UpsertInferenceService(inferenceService)
+}
+
+func (a *validatingApi) UpsertServeModel(serveModel *openapi.ServeModel, inferenceServiceId *string) (*openapi.ServeModel, error) {
+	id, _ := serveModel.GetIdOk()
+	if err := validate(a.validator, ServeModel, serveModel, id, a.GetServeModelById); err != nil {
+		return nil, err
+	}
+	return a.ModelRegistryApi.UpsertServeModel(serveModel, inferenceServiceId)
+}
+
+// getDocArtifactById returns the doc artifact with the provided id, nil if the artifact is of another type
+func (a *validatingApi) getDocArtifactById(id string) (*openapi.DocArtifact, error) {
+	artifact, err := a.GetArtifactById(id)
+	if err != nil {
+		return nil, err
+	}
+	return artifact.DocArtifact, nil
+}
@@ -0,0 +1,429 @@
+// Package validation checks the content of registry entities before they're stored, reporting every violation
+// found at once as an api.ValidationError. Besides the built-in checks, operators can declare their own rules
+// in the configuration, and library users can plug in their own checks.
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/kubeflow/model-registry/pkg/api"
+)
+
+// Entity types, as named in rules
+const (
+	RegisteredModel    = "RegisteredModel"
+	ModelVersion       = "ModelVersion"
+	ModelArtifact      = "ModelArtifact"
+	DocArtifact        = "DocArtifact"
+	ServingEnvironment = "ServingEnvironment"
+	InferenceService   = "InferenceService"
+	ServeModel         = "ServeModel"
+)
+
+var entityTypes = []string{RegisteredModel, ModelVersion, ModelArtifact, DocArtifact, ServingEnvironment, InferenceService, ServeModel}
+
+// stateFields maps each entity type having a state to its state field
+var stateFields = map[string]string{
+	RegisteredModel:  "state",
+	ModelVersion:     "state",
+	ModelArtifact:    "state",
+	DocArtifact:      "state",
+	InferenceService: "desiredState",
+	ServeModel:       "lastKnownState",
+}
+
+// Config holds the built-in checks settings and the operator rules
+type Config struct {
+	// NameMaxLength bounds the length of entity names, unbounded when 0
+	NameMaxLength int
+	// NamePattern is a regular expression names must match, in addition to the built-in syntax rules, if set
+	NamePattern string
+	// CustomPropertyKeyMaxLength bounds the length of custom property keys, unbounded when 0
+	CustomPropertyKeyMaxLength int
+	// CustomPropertyKeyPattern is a regular expression custom property keys must match, if set
+	CustomPropertyKeyPattern string
+	// ReservedPrefixes are custom property key prefixes reserved to the registry itself
+	ReservedPrefixes []string
+	// UriSchemes lists the schemes allowed in ModelArtifact URIs, any scheme is allowed when empty
+	UriSchemes []string
+	// Rules are additional rules declared by operators, applied after the built-in ones
+	Rules []Rule
+}
+
+// Rule is a declarative check of a single field, applied to the entity types and states it's scoped to
+type Rule struct {
+	// Name identifies the rule in violation messages
+	Name string
+	// EntityTypes lists the entity types the rule applies to, all when empty
+	EntityTypes []string
+	// States lists the states the entity must be in for the rule to apply, any when empty
+	States []string
+	// FromStates lists the states the stored entity must be in for the rule to apply, i.e. the rule only applies to
+	// updates transitioning from one of these states; any when empty
+	FromStates []string
+	// Field is the path of the checked field, e.g. owner or customProperties.team
+	Field string
+	// Required rejects entities missing the field
+	Required bool
+	// Pattern is a regular expression the field value must match, if set
+	Pattern string
+	// MaxLength bounds the length of the field value, unbounded when 0
+	MaxLength int
+	// AllowedValues lists the values the field may have, any when empty
+	AllowedValues []string
+	// Message replaces the default violation message, if set
+	Message string
+}
+
+// NewDefaultConfig returns the default validation configuration
+func NewDefaultConfig() Config {
+	return Config{
+		NameMaxLength:              200,
+		CustomPropertyKeyMaxLength: 255,
+		ReservedPrefixes:           []string{ReservedPrefix},
+	}
+}
+
+// stateRules are the built-in rules on the fields required by entities in a given state
+var stateRules = []Rule{
+	{
+		Name:        "live-model-artifact-uri",
+		EntityTypes: []string{ModelArtifact},
+		States:      []string{"LIVE"},
+		Field:       "uri",
+		Required:    true,
+		Message:     "is required for LIVE model artifacts",
+	},
+}
+
+// ReservedPrefix is the custom property key prefix reserved to the registry by default
+const ReservedPrefix = "_mr."
+
+// Entity is the view of a registry entity being stored, as checked by rules
+type Entity struct {
+	// Type is one of the entity types, e.g. RegisteredModel
+	Type string
+	// Fields is the JSON representation of the entity being stored
+	Fields map[string]any
+	// Existing is the JSON representation of the stored entity when updating, nil when creating
+	Existing map[string]any
+}
+
+// NewEntity creates the view of the provided entity, and of the stored one when updating, i.e. when existing is not nil
+func NewEntity(entityType string, entity any, existing any) (*Entity, error) {
+	fields, err := toMap(entity)
+	if err != nil {
+		return nil, err
+	}
+	e := &Entity{Type: entityType, Fields: fields}
+	if existing != nil {
+		if e.Existing, err = toMap(existing); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+func toMap(entity any) (map[string]any, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling entity: %w", err)
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("error unmarshaling entity: %w", err)
+	}
+	return fields, nil
+}
+
+// Lookup returns the value at the provided dotted path, custom properties resolve to their plain value
+func (e *Entity) Lookup(path string) (any, bool) {
+	return lookup(e.Fields, path)
+}
+
+// State returns the state of the entity, empty for entity types without one
+func (e *Entity) State() string {
+	return stateOf(e.Type, e.Fields)
+}
+
+// ExistingState returns the state of the stored entity, empty when creating
+func (e *Entity) ExistingState() string {
+	return stateOf(e.Type, e.Existing)
+}
+
+func stateOf(entityType string, fields map[string]any) string {
+	field, ok := stateFields[entityType]
+	if !ok || fields == nil {
+		return ""
+	}
+	state, _ := fields[field].(string)
+	return state
+}
+
+func lookup(fields map[string]any, path string) (any, bool) {
+	var current any = fields
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[part]; !ok || current == nil {
+			return nil, false
+		}
+	}
+	// unwrap MetadataValue objects, e.g. {"metadataType": "MetadataStringValue", "string_value": "x"}
+	if m, ok := current.(map[string]any); ok {
+		if _, ok := m["metadataType"]; ok {
+			for k, v := range m {
+				if strings.HasSuffix(k, "_value") {
+					return v, true
+				}
+			}
+		}
+	}
+	return current, true
+}
+
+// Check inspects an entity, returning the violations found
+type Check func(e *Entity) []api.FieldError
+
+// Validator runs the built-in checks, the operator rules and any additional check on entities
+type Validator struct {
+	checks []Check
+}
+
+// NewValidator creates a Validator from the provided configuration, failing on invalid rules.
+// The additional checks run after the built-in ones and the configured rules.
+func NewValidator(cfg Config, checks ...Check) (*Validator, error) {
+	builtins, err := builtinChecks(cfg)
+	if err != nil {
+		return nil, err
+	}
+	v := &Validator{checks: builtins}
+	for _, rule := range stateRules {
+		check, err := compileRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		v.checks = append(v.checks, check)
+	}
+	for i, rule := range cfg.Rules {
+		check, err := compileRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validation rule %d (%s): %w", i, rule.Name, err)
+		}
+		v.checks = append(v.checks, check)
+	}
+	v.checks = append(v.checks, checks...)
+	return v, nil
+}
+
+// Validate runs every check on the entity, returning an api.ValidationError listing all violations, if any
+func (v *Validator) Validate(e *Entity) error {
+	violations := []api.FieldError{}
+	for _, check := range v.checks {
+		violations = append(violations, check(e)...)
+	}
+	if len(violations) > 0 {
+		return api.NewValidationError(violations...)
+	}
+	return nil
+}
+
+func builtinChecks(cfg Config) ([]Check, error) {
+	var namePattern, keyPattern *regexp.Regexp
+	var err error
+	if cfg.NamePattern != "" {
+		if namePattern, err = regexp.Compile(cfg.NamePattern); err != nil {
+			return nil, fmt.Errorf("invalid name pattern: %w", err)
+		}
+	}
+	if cfg.CustomPropertyKeyPattern != "" {
+		if keyPattern, err = regexp.Compile(cfg.CustomPropertyKeyPattern); err != nil {
+			return nil, fmt.Errorf("invalid custom property key pattern: %w", err)
+		}
+	}
+	schemes := make([]string, 0, len(cfg.UriSchemes))
+	for _, scheme := range cfg.UriSchemes {
+		schemes = append(schemes, strings.ToLower(scheme))
+	}
+
+	return []Check{
+		nameCheck(cfg.NameMaxLength, namePattern),
+		customPropertiesCheck(cfg.CustomPropertyKeyMaxLength, keyPattern, cfg.ReservedPrefixes),
+		uriSchemeCheck(schemes),
+	}, nil
+}
+
+// nameCheck rejects empty names, names with leading or trailing whitespace or control characters, too long names
+// and names not matching the configured pattern
+func nameCheck(maxLength int, pattern *regexp.Regexp) Check {
+	return func(e *Entity) []api.FieldError {
+		value, ok := e.Fields["name"]
+		if !ok {
+			return nil
+		}
+		name, _ := value.(string)
+		violation := func(msg string, args ...any) []api.FieldError {
+			return []api.FieldError{{Field: "name", Message: fmt.Sprintf(msg, args...)}}
+		}
+		switch {
+		case name == "":
+			return violation("must not be empty")
+		case strings.TrimSpace(name) != name:
+			return violation("must not start or end with whitespace")
+		case strings.IndexFunc(name, unicode.IsControl) >= 0:
+			return violation("must not contain control characters")
+		case maxLength > 0 && len(name) > maxLength:
+			return violation("must be at most %d characters long", maxLength)
+		case pattern != nil && !pattern.MatchString(name):
+			return violation("must match %s", pattern)
+		}
+		return nil
+	}
+}
+
+// customPropertiesCheck rejects invalid custom property keys, and changes to keys with a reserved prefix
+func customPropertiesCheck(maxLength int, pattern *regexp.Regexp, reservedPrefixes []string) Check {
+	return func(e *Entity) []api.FieldError {
+		properties, _ := e.Fields["customProperties"].(map[string]any)
+		existing := map[string]any{}
+		if e.Existing != nil {
+			existing, _ = e.Existing["customProperties"].(map[string]any)
+		}
+
+		violations := []api.FieldError{}
+		for _, key := range sortedKeys(properties) {
+			field := "customProperties." + key
+			violation := func(msg string, args ...any) {
+				violations = append(violations, api.FieldError{Field: field, Message: fmt.Sprintf(msg, args...)})
+			}
+			switch {
+			case key == "":
+				violation("key must not be empty")
+			case strings.TrimSpace(key) != key:
+				violation("key must not start or end with whitespace")
+			case strings.IndexFunc(key, unicode.IsControl) >= 0:
+				violation("key must not contain control characters")
+			case maxLength > 0 && len(key) > maxLength:
+				violation("key must be at most %d characters long", maxLength)
+			case pattern != nil && !pattern.MatchString(key):
+				violation("key must match %s", pattern)
+			default:
+				for _, prefix := range reservedPrefixes {
+					// reserved keys set by the registry are sent back as they are on updates
+					if strings.HasPrefix(key, prefix) && !jsonEqual(properties[key], existing[key]) {
+						violation("key prefix %s is reserved", prefix)
+						break
+					}
+				}
+			}
+		}
+		return violations
+	}
+}
+
+// uriSchemeCheck rejects ModelArtifact URIs whose scheme is not allowed, when schemes is not empty
+func uriSchemeCheck(schemes []string) Check {
+	return func(e *Entity) []api.FieldError {
+		if e.Type != ModelArtifact || len(schemes) == 0 {
+			return nil
+		}
+		value, ok := e.Fields["uri"].(string)
+		if !ok {
+			return nil
+		}
+		uri, err := url.Parse(value)
+		if err != nil {
+			return []api.FieldError{{Field: "uri", Message: fmt.Sprintf("must be a valid URI: %v", err)}}
+		}
+		if !slices.Contains(schemes, strings.ToLower(uri.Scheme)) {
+			return []api.FieldError{{Field: "uri", Message: fmt.Sprintf("scheme %q is not allowed, expected one of %s", uri.Scheme, strings.Join(schemes, ", "))}}
+		}
+		return nil
+	}
+}
+
+// compileRule turns a declarative rule into a Check
+func compileRule(rule Rule) (Check, error) {
+	if rule.Field == "" {
+		return nil, fmt.Errorf("missing field")
+	}
+	for _, entityType := range rule.EntityTypes {
+		if !slices.Contains(entityTypes, entityType) {
+			return nil, fmt.Errorf("unknown entity type %s, expected one of %s", entityType, strings.Join(entityTypes, ", "))
+		}
+	}
+	var pattern *regexp.Regexp
+	if rule.Pattern != "" {
+		var err error
+		if pattern, err = regexp.Compile(rule.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+
+	return func(e *Entity) []api.FieldError {
+		if len(rule.EntityTypes) > 0 && !slices.Contains(rule.EntityTypes, e.Type) {
+			return nil
+		}
+		if len(rule.States) > 0 && !slices.Contains(rule.States, e.State()) {
+			return nil
+		}
+		if len(rule.FromStates) > 0 && (e.Existing == nil || !slices.Contains(rule.FromStates, e.ExistingState())) {
+			return nil
+		}
+
+		violation := func(msg string, args ...any) []api.FieldError {
+			if rule.Message != "" {
+				msg = rule.Message
+			} else {
+				msg = fmt.Sprintf(msg, args...)
+			}
+			if rule.Name != "" {
+				msg = fmt.Sprintf("%s (rule %s)", msg, rule.Name)
+			}
+			return []api.FieldError{{Field: rule.Field, Message: msg}}
+		}
+
+		value, ok := e.Lookup(rule.Field)
+		if !ok {
+			if rule.Required {
+				return violation("is required")
+			}
+			return nil
+		}
+		str := fmt.Sprint(value)
+		switch {
+		case rule.Required && str == "":
+			return violation("is required")
+		case rule.MaxLength > 0 && len(str) > rule.MaxLength:
+			return violation("must be at most %d characters long", rule.MaxLength)
+		case pattern != nil && !pattern.MatchString(str):
+			return violation("must match %s", pattern)
+		case len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, str):
+			return violation("must be one of %s", strings.Join(rule.AllowedValues, ", "))
+		}
+		return nil
+	}, nil
+}
+
+func jsonEqual(a, b any) bool {
+	aData, aErr := json.Marshal(a)
+	bData, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aData) == string(bData)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
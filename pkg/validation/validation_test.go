@@ -0,0 +1,266 @@
+package validation
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/pkg/api"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeApi only implements the operations exercised by the tests
+type fakeApi struct {
+	api.ModelRegistryApi
+	stored map[string]*openapi.ModelArtifact
+}
+
+func (f *fakeApi) UpsertModelArtifact(modelArtifact *openapi.ModelArtifact, modelVersionId *string) (*openapi.ModelArtifact, error) {
+	return modelArtifact, nil
+}
+
+func (f *fakeApi) GetModelArtifactById(id string) (*openapi.ModelArtifact, error) {
+	if ma, ok := f.stored[id]; ok {
+		return ma, nil
+	}
+	return nil, api.ErrNotFound
+}
+
+func stringValue(value string) openapi.MetadataValue {
+	return openapi.MetadataStringValueAsMetadataValue(openapi.NewMetadataStringValue(value, "MetadataStringValue"))
+}
+
+func setup(t *testing.T, cfg Config) (*assert.Assertions, *Validator) {
+	v, err := NewValidator(cfg)
+	if err != nil {
+		t.Fatalf("error creating validator: %v", err)
+	}
+	return assert.New(t), v
+}
+
+func validateEntity(t *testing.T, v *Validator, entityType string, entity any, existing any) []api.FieldError {
+	e, err := NewEntity(entityType, entity, existing)
+	if err != nil {
+		t.Fatalf("error creating entity: %v", err)
+	}
+	return api.ErrToDetails(v.Validate(e))
+}
+
+func TestValidateReportsAllViolations(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.UriSchemes = []string{"s3"}
+	assertion, v := setup(t, cfg)
+
+	err := v.Validate(&Entity{Type: ModelArtifact, Fields: map[string]any{
+		"name":             " model",
+		"uri":              "ftp://host/model",
+		"customProperties": map[string]any{"_mr.digest": map[string]any{"metadataType": "MetadataStringValue", "string_value": "x"}},
+	}})
+
+	assertion.ErrorIs(err, api.ErrValidation)
+	assertion.Equal(http.StatusUnprocessableEntity, api.ErrToStatus(err))
+	details := api.ErrToDetails(err)
+	assertion.Len(details, 3)
+	assertion.Equal("name", details[0].Field)
+	assertion.Equal("customProperties._mr.digest", details[1].Field)
+	assertion.Equal("uri", details[2].Field)
+}
+
+func TestValidateNames(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.NameMaxLength = 10
+	cfg.NamePattern = "^[a-z0-9-]+$"
+	assertion, v := setup(t, cfg)
+
+	for name, valid := range map[string]bool{
+		"model-1":       true,
+		"":              false,
+		"model ":        false,
+		"mod\nel":       false,
+		"a-longer-name": false,
+		"Model":         false,
+	} {
+		violations := validateEntity(t, v, RegisteredModel, &openapi.RegisteredModel{Name: apiutils.Of(name)}, nil)
+		assertion.Equal(valid, len(violations) == 0, "name %q", name)
+	}
+
+	// names are not required on updates
+	assertion.Empty(validateEntity(t, v, RegisteredModel, &openapi.RegisteredModel{Id: apiutils.Of("1")}, nil))
+}
+
+func TestValidateCustomPropertyKeys(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.CustomPropertyKeyMaxLength = 8
+	assertion, v := setup(t, cfg)
+
+	violations := validateEntity(t, v, RegisteredModel, &openapi.RegisteredModel{
+		Name: apiutils.Of("model"),
+		CustomProperties: &map[string]openapi.MetadataValue{
+			"team":        stringValue("a"),
+			" team":       stringValue("a"),
+			"much-longer": stringValue("a"),
+		},
+	}, nil)
+
+	assertion.Len(violations, 2)
+	assertion.Equal("customProperties. team", violations[0].Field)
+	assertion.Equal("customProperties.much-longer", violations[1].Field)
+}
+
+func TestValidateReservedPrefixAllowsUnchangedValues(t *testing.T) {
+	assertion, v := setup(t, NewDefaultConfig())
+
+	existing := &openapi.RegisteredModel{
+		Id:               apiutils.Of("1"),
+		Name:             apiutils.Of("model"),
+		CustomProperties: &map[string]openapi.MetadataValue{"_mr.owner": stringValue("registry")},
+	}
+	unchanged := &openapi.RegisteredModel{
+		Id:               apiutils.Of("1"),
+		CustomProperties: &map[string]openapi.MetadataValue{"_mr.owner": stringValue("registry")},
+	}
+	changed := &openapi.RegisteredModel{
+		Id:               apiutils.Of("1"),
+		CustomProperties: &map[string]openapi.MetadataValue{"_mr.owner": stringValue("me")},
+	}
+
+	assertion.Empty(validateEntity(t, v, RegisteredModel, unchanged, existing))
+	violations := validateEntity(t, v, RegisteredModel, changed, existing)
+	assertion.Len(violations, 1)
+	assertion.Contains(violations[0].Message, "reserved")
+	assertion.Len(validateEntity(t, v, RegisteredModel, unchanged, nil), 1)
+}
+
+func TestValidateUriSchemes(t *testing.T) {
+	// any scheme is allowed by default
+	assertion, v := setup(t, NewDefaultConfig())
+	assertion.Empty(validateEntity(t, v, ModelArtifact, &openapi.ModelArtifact{Uri: apiutils.Of("file:///tmp/model")}, nil))
+
+	cfg := NewDefaultConfig()
+	cfg.UriSchemes = []string{"s3", "pvc"}
+	_, v = setup(t, cfg)
+	assertion.Empty(validateEntity(t, v, ModelArtifact, &openapi.ModelArtifact{Uri: apiutils.Of("S3://bucket/model")}, nil))
+	assertion.Len(validateEntity(t, v, ModelArtifact, &openapi.ModelArtifact{Uri: apiutils.Of("file:///tmp/model")}, nil), 1)
+	// doc artifacts can point anywhere
+	assertion.Empty(validateEntity(t, v, DocArtifact, &openapi.DocArtifact{Uri: apiutils.Of("file:///tmp/README.md")}, nil))
+}
+
+func TestValidateLiveModelArtifactRequiresUri(t *testing.T) {
+	assertion, v := setup(t, NewDefaultConfig())
+
+	violations := validateEntity(t, v, ModelArtifact, &openapi.ModelArtifact{State: openapi.ARTIFACTSTATE_LIVE.Ptr()}, nil)
+	assertion.Len(violations, 1)
+	assertion.Equal("uri", violations[0].Field)
+
+	assertion.Empty(validateEntity(t, v, ModelArtifact, &openapi.ModelArtifact{State: openapi.ARTIFACTSTATE_PENDING.Ptr()}, nil))
+}
+
+func TestValidateRules(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.Rules = []Rule{
+		{
+			Name:        "archive-reason",
+			EntityTypes: []string{ModelVersion},
+			States:      []string{"ARCHIVED"},
+			FromStates:  []string{"LIVE"},
+			Field:       "customProperties.archiveReason",
+			Required:    true,
+		},
+		{
+			Name:          "framework",
+			EntityTypes:   []string{ModelArtifact},
+			Field:         "modelFormatName",
+			AllowedValues: []string{"onnx", "pytorch"},
+		},
+		{
+			Field:   "author",
+			Pattern: "^[a-z]+@example\\.com$",
+			Message: "must be a company address",
+		},
+	}
+	assertion, v := setup(t, cfg)
+
+	live := &openapi.ModelVersion{Id: apiutils.Of("1"), Name: apiutils.Of("v1"), State: openapi.MODELVERSIONSTATE_LIVE.Ptr()}
+	archived := &openapi.ModelVersion{Id: apiutils.Of("1"), State: openapi.MODELVERSIONSTATE_ARCHIVED.Ptr()}
+	violations := validateEntity(t, v, ModelVersion, archived, live)
+	assertion.Len(violations, 1)
+	assertion.Equal("customProperties.archiveReason", violations[0].Field)
+	assertion.Contains(violations[0].Message, "rule archive-reason")
+
+	archived.CustomProperties = &map[string]openapi.MetadataValue{"archiveReason": stringValue("superseded")}
+	assertion.Empty(validateEntity(t, v, ModelVersion, archived, live))
+	// the transition rule doesn't apply to versions created as archived
+	assertion.Empty(validateEntity(t, v, ModelVersion, &openapi.ModelVersion{Name: apiutils.Of("v2"), State: openapi.MODELVERSIONSTATE_ARCHIVED.Ptr()}, nil))
+
+	assertion.Len(validateEntity(t, v, ModelArtifact, &openapi.ModelArtifact{ModelFormatName: apiutils.Of("tensorflow")}, nil), 1)
+	assertion.Empty(validateEntity(t, v, ModelArtifact, &openapi.ModelArtifact{ModelFormatName: apiutils.Of("onnx")}, nil))
+
+	violations = validateEntity(t, v, ModelVersion, &openapi.ModelVersion{Name: apiutils.Of("v3"), Author: apiutils.Of("someone@elsewhere.org")}, nil)
+	assertion.Len(violations, 1)
+	assertion.Equal("must be a company address", violations[0].Message)
+}
+
+func TestNewValidatorRejectsInvalidRules(t *testing.T) {
+	assertion := assert.New(t)
+
+	for _, rule := range []Rule{
+		{Name: "no-field", Required: true},
+		{Name: "bad-type", Field: "name", EntityTypes: []string{"Model"}},
+		{Name: "bad-pattern", Field: "name", Pattern: "("},
+	} {
+		cfg := NewDefaultConfig()
+		cfg.Rules = []Rule{rule}
+		_, err := NewValidator(cfg)
+		if assertion.NotNil(err, rule.Name) {
+			assertion.True(strings.Contains(err.Error(), rule.Name), err.Error())
+		}
+	}
+
+	cfg := NewDefaultConfig()
+	cfg.NamePattern = "["
+	_, err := NewValidator(cfg)
+	assertion.NotNil(err)
+}
+
+func TestAdditionalChecks(t *testing.T) {
+	assertion := assert.New(t)
+	v, err := NewValidator(NewDefaultConfig(), func(e *Entity) []api.FieldError {
+		if _, ok := e.Lookup("description"); !ok {
+			return []api.FieldError{{Field: "description", Message: "is required"}}
+		}
+		return nil
+	})
+	assertion.Nil(err)
+
+	violations := validateEntity(t, v, RegisteredModel, &openapi.RegisteredModel{Name: apiutils.Of("model")}, nil)
+	assertion.Len(violations, 1)
+	assertion.Equal("description", violations[0].Field)
+}
+
+func TestValidatingApi(t *testing.T) {
+	assertion, v := setup(t, NewDefaultConfig())
+	delegate := &fakeApi{stored: map[string]*openapi.ModelArtifact{
+		"1": {
+			Id:               apiutils.Of("1"),
+			Name:             apiutils.Of("model"),
+			Uri:              apiutils.Of("s3://bucket/model"),
+			CustomProperties: &map[string]openapi.MetadataValue{"_mr.digest": stringValue("sha256:abc")},
+		},
+	}}
+	validating := v.ValidatingApi(delegate)
+
+	_, err := validating.UpsertModelArtifact(&openapi.ModelArtifact{Name: apiutils.Of(" model"), Uri: apiutils.Of("s3://bucket/model")}, nil)
+	assertion.ErrorIs(err, api.ErrValidation)
+
+	// reserved properties of the stored artifact can be sent back as they are
+	_, err = validating.UpsertModelArtifact(&openapi.ModelArtifact{
+		Id:               apiutils.Of("1"),
+		CustomProperties: &map[string]openapi.MetadataValue{"_mr.digest": stringValue("sha256:abc")},
+	}, nil)
+	assertion.Nil(err)
+
+	_, err = validating.(api.TenantScopedApi).ForTenant("team-a")
+	assertion.NotNil(err)
+}